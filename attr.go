@@ -4,6 +4,10 @@ package aci
 attr.go contains LDAP AttributeType types and methods.
 */
 
+import (
+	"sync"
+)
+
 /*
 invalid value constants used as stringer method returns when
 something goes wrong :/
@@ -18,6 +22,99 @@ var (
 	badAttributeValue AttributeValue // for failed calls that return an AttributeValue only
 )
 
+/*
+AttributeCase constants control the case normalization of [AttributeType] names pushed into instances of [AttributeTypes]. See the [AttributeTypeCaseMode] global variable and the [AttributeTypes.SetCase] method.
+*/
+const (
+	AttributeCasePreserve   = iota // 0: names are used as provided (default)
+	AttributeCaseLower             // 1: names are lowercased
+	AttributeCaseRegistered        // 2: names are set to the case used during registration
+)
+
+/*
+AttributeTypeCaseMode is a global variable that controls the case normalization of [AttributeType] names pushed into instances of [AttributeTypes], including those produced during parsing. By default, names are used as provided ([AttributeCasePreserve]).
+
+Individual [AttributeTypes] instances may override this setting using the [AttributeTypes.SetCase] method.
+*/
+var AttributeTypeCaseMode int = AttributeCasePreserve
+
+/*
+caseKey is the auxiliary key under which an [AttributeTypes] instance stores its case normalization mode, if set.
+*/
+const caseKey = `case`
+
+/*
+registeredAttributeTypes contains canonical [AttributeType] names, keyed by their lowercased forms, for use when the [AttributeCaseRegistered] mode is in effect.
+*/
+var (
+	registeredAttributeTypes   map[string]string = make(map[string]string)
+	registeredAttributeTypesMu sync.RWMutex
+)
+
+/*
+RegisterAttributeTypes registers one (1) or more base [AttributeType] names in their canonical case (e.g.: `givenName`) for use when the [AttributeCaseRegistered] mode is in effect. Registering a name again, in any case, replaces the prior registration. Invalid names, as well as names bearing options, are ignored.
+
+This function is safe for concurrent use.
+*/
+func RegisterAttributeTypes(names ...string) {
+	registeredAttributeTypesMu.Lock()
+	defer registeredAttributeTypesMu.Unlock()
+
+	for i := 0; i < len(names); i++ {
+		if isIdentifier(names[i]) && !contains(names[i], `;`) {
+			registeredAttributeTypes[lc(names[i])] = names[i]
+		}
+	}
+}
+
+/*
+SchemaChecker is a user-implemented interface type used to verify that [AttributeType] names exist within the schema of a directory server. The IsKnownAttribute method shall return a Boolean value indicative of whether the base name (i.e.: less any options) is known. Case should not be significant.
+
+This package performs no schema retrieval of its own. Implementations may consult a directory server's subschema subentry, a static list or any other suitable source.
+
+See the [AttributeSchemaChecker] global variable and the [AttributeTypes.SetSchemaChecker] method.
+*/
+type SchemaChecker interface {
+	IsKnownAttribute(name string) bool
+}
+
+/*
+AttributeSchemaChecker is a global variable that, when non-nil, is consulted by the [AttributeTypes.Valid] method to verify that each [AttributeType] name within the receiver exists within the directory schema. This catches typographical errors, such as `givenNam`, which are otherwise syntactically valid. By default, no schema checking is performed.
+
+Individual [AttributeTypes] instances may override this setting using the [AttributeTypes.SetSchemaChecker] method.
+*/
+var AttributeSchemaChecker SchemaChecker
+
+/*
+schemaKey is the auxiliary key under which an [AttributeTypes] instance stores its [SchemaChecker], if set.
+*/
+const schemaKey = `schema`
+
+/*
+normalizeAttributeTypeCase returns the input [AttributeType] name normalized according to mode. Names that have not been registered are returned as-is when the [AttributeCaseRegistered] mode is in effect.
+*/
+func normalizeAttributeTypeCase(name string, mode int) string {
+	switch mode {
+	case AttributeCaseLower:
+		name = lc(name)
+	case AttributeCaseRegistered:
+		// only the base type is registered; any
+		// options are retained as-is.
+		base, opts := name, ``
+		if idx := idxr(name, ';'); idx != -1 {
+			base, opts = name[:idx], name[idx:]
+		}
+
+		registeredAttributeTypesMu.RLock()
+		if canon, found := registeredAttributeTypes[lc(base)]; found {
+			name = canon + opts
+		}
+		registeredAttributeTypesMu.RUnlock()
+	}
+
+	return name
+}
+
 /*
 AttributeTypeContext is a convenient interface type that is qualified by the following types:
 
@@ -134,6 +231,8 @@ func userOrGroupAttr(t BindKeyword, x ...any) (A AttributeBindTypeOrValue) {
 
 /*
 Set assigns value(s) x to the receiver. The value(s) must be [AttributeType] and/or [AttributeValue] instances, created via the package-level [AT] and [AV] functions respectively.
+
+Alternatively, the value may be an [LDAPURI] instance, such as one created via the package-level [URL] function, describing the members of a dynamic group, e.g.: `member#ldap:///ou=People,dc=example,dc=com??sub?(objectClass=person)`. Such values are only permitted in [BindGAT] (groupattr) contexts.
 */
 func (r *AttributeBindTypeOrValue) Set(x ...any) *AttributeBindTypeOrValue {
 	if r.IsZero() {
@@ -147,7 +246,7 @@ func (r *AttributeBindTypeOrValue) Set(x ...any) *AttributeBindTypeOrValue {
 Eq initializes and returns a new [BindRule] instance configured to express the evaluation of the receiver value as Equal-To a [BindUAT] or [BindGAT] [BindKeyword] context.
 */
 func (r AttributeBindTypeOrValue) Eq() (b BindRule) {
-	if !r.atbtv.isZero() {
+	if !r.atbtv.isZero() && r.Valid() == nil {
 		b = BR(r.BindKeyword, Eq, r)
 	}
 	return
@@ -159,7 +258,7 @@ Ne initializes and returns a new [BindRule] instance configured to express the e
 Negated equality [BindRule] instances should be used with caution.
 */
 func (r AttributeBindTypeOrValue) Ne() (b BindRule) {
-	if !r.atbtv.isZero() {
+	if !r.atbtv.isZero() && r.Valid() == nil {
 		b = BR(r.BindKeyword, Ne, r)
 	}
 	return
@@ -181,6 +280,15 @@ func (r AttributeBindTypeOrValue) BRM() BindRuleMethods {
 	})
 }
 
+/*
+Rule returns a [BindRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [BindRule] is returned if op is not permitted for use with the receiver.
+
+See also the [AttributeBindTypeOrValue.BRM] method.
+*/
+func (r AttributeBindTypeOrValue) Rule(op any) BindRule {
+	return r.BRM().rule(op)
+}
+
 /*
 Keyword returns the [BindKeyword] associated with the receiver instance, enveloped as a [Keyword]. In the context of this type instance, the [BindKeyword] returned will be either [BindUAT] or [BindGAT].
 */
@@ -216,6 +324,15 @@ func (r atbtv) String() (s string) {
 	// Assert the attributeType value
 	// or bail out.
 	if at, assert := r[0].(AttributeType); assert {
+		// An LDAP URL (groupattr only) is rendered
+		// as-is, if valid.
+		if uri, isURI := r[1].(LDAPURI); isURI {
+			if uri.Valid() == nil {
+				s = sprintf("%s#%s", at, uri)
+			}
+			return
+		}
+
 		// First see if the value is a BindType
 		// keyword, as those are few and easily
 		// identified.
@@ -252,7 +369,14 @@ func (r *atbtv) set(x ...any) {
 			if r[0] == nil {
 				r[0] = tv
 			}
-		case AttributeValue, BindType:
+		case AttributeValue:
+			if r[1] == nil {
+				r[1] = tv
+				if bt := matchBT(tv.String()); bt != BindType(0x0) {
+					r[1] = bt
+				}
+			}
+		case BindType, LDAPURI:
 			if r[1] == nil {
 				r[1] = tv
 			}
@@ -262,6 +386,8 @@ func (r *atbtv) set(x ...any) {
 			} else {
 				if bt := matchBT(tv); bt != BindType(0x0) {
 					r[1] = bt
+				} else if hasPfx(lc(tv), `ldap://`) {
+					r[1] = URL(tv)
 				} else {
 					r[1] = AV(tv)
 				}
@@ -289,7 +415,7 @@ If no suitable [BindKeyword] is provided (bkw), the default is [BindUAT]. Valid
 */
 func (r *AttributeBindTypeOrValue) Parse(raw string, bkw ...any) (err error) {
 	var _r AttributeBindTypeOrValue
-	if _r, err = parseATBTV(raw, bkw); err != nil {
+	if _r, err = parseATBTV(raw, bkw...); err != nil {
 		return
 	}
 	*r = _r
@@ -299,11 +425,23 @@ func (r *AttributeBindTypeOrValue) Parse(raw string, bkw ...any) (err error) {
 
 /*
 Valid returns an error indicative of whether the receiver is in an aberrant state.
+
+An [LDAPURI] value must be valid, must bear the [BindGAT] [BindKeyword] and must itself describe a search (i.e.: it cannot bear an [AttributeBindTypeOrValue] component of its own).
 */
 func (r AttributeBindTypeOrValue) Valid() (err error) {
 	err = nilInstanceErr(r)
 	if !r.IsZero() {
 		err = nil
+		switch tv := r.atbtv[1].(type) {
+		case AttributeValue:
+			err = tv.Valid()
+		case LDAPURI:
+			if err = tv.Valid(); err == nil {
+				if r.BindKeyword != BindGAT || !tv.ldapURI.avbt.IsZero() {
+					err = atbtvURLErr(tv, r.BindKeyword)
+				}
+			}
+		}
 	}
 
 	return
@@ -347,7 +485,24 @@ func parseATBTV(x string, bkw ...any) (A AttributeBindTypeOrValue, err error) {
 		return
 	}
 
-	A = userOrGroupAttr(kw, at, av)
+	// If the remaining portion of the value is an
+	// LDAP URL (dynamic group), verify it fully.
+	if hasPfx(lc(x[idx+1:]), `ldap://`) {
+		var uri LDAPURI
+		if uri, err = parseURL(x[idx+1:]); err == nil {
+			if cand := userOrGroupAttr(kw, at, uri); cand.Valid() == nil {
+				A = cand
+			} else {
+				err = cand.Valid()
+			}
+		}
+		return
+	}
+
+	if err = av.Valid(); err == nil {
+		A = userOrGroupAttr(kw, at, av)
+	}
+
 	return
 }
 
@@ -416,16 +571,82 @@ func (r AttributeType) TRM() TargetRuleMethods {
 }
 
 /*
-AT initializes, sets and returns an [AttributeType] instance in one shot. The input value x shall be an RFC 4512 Section 2.5 compliant descriptor (e.g.: `manager`).
+Rule returns a [TargetRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [TargetRule] is returned if op is not permitted for use with the receiver.
+
+See also the [AttributeType.TRM] method.
+*/
+func (r AttributeType) Rule(op any) TargetRule {
+	return r.TRM().rule(op)
+}
+
+/*
+AT initializes, sets and returns an [AttributeType] instance in one shot. The input value x shall be an RFC 4512 Section 2.5 compliant descriptor (e.g.: `manager`), optionally followed by one (1) or more semicolon-delimited attribute options (e.g.: `userCertificate;binary` or `cn;lang-en`). The input is used as written. Options are not case-sensitive, thus `cn;lang-EN` and `cn;lang-en` are considered equal wherever [AttributeType] instances are matched, such as by the [AttributeTypes.Contains] method.
 */
 func AT(x string) (A AttributeType) {
-	if isIdentifier(x) || x == `*` {
+	if x == `*` {
+		A = AttributeType{&x}
+	} else if _, _, ok := splitAttributeType(x); ok {
 		A = AttributeType{&x}
 	}
 
 	return
 }
 
+/*
+Base returns the base [AttributeType] name of the receiver, less any options. For example, `userCertificate` is returned for `userCertificate;binary`. A zero string is returned if the receiver is nil, or unset.
+*/
+func (r AttributeType) Base() (base string) {
+	if !r.IsZero() {
+		base, _, _ = splitAttributeType(*r.string)
+	}
+
+	return
+}
+
+/*
+Options returns slices of the attribute options (also known as tags) present within the receiver, such as `binary` or `lang-en`, in the order in which they appear. A nil slice is returned if no options are present, or if the receiver is nil, or unset.
+*/
+func (r AttributeType) Options() (opts []string) {
+	if !r.IsZero() {
+		_, opts, _ = splitAttributeType(*r.string)
+	}
+
+	return
+}
+
+/*
+splitAttributeType splits the input value into its base [AttributeType] name and its options, each returned as written. A Boolean value is returned indicative of whether the base name is a valid descriptor, and whether each option is a non-zero sequence of alphanumeric characters and hyphens, per RFC 4512 Section 2.5.
+*/
+func splitAttributeType(x string) (base string, opts []string, ok bool) {
+	parts := split(x, `;`)
+	if base = parts[0]; !isIdentifier(base) {
+		return
+	}
+
+	for i := 1; i < len(parts); i++ {
+		if !isAttributeOption(parts[i]) {
+			return
+		}
+		opts = append(opts, parts[i])
+	}
+	ok = true
+
+	return
+}
+
+/*
+isAttributeOption returns a Boolean value indicative of whether x is a non-zero sequence of alphanumeric characters and hyphens.
+*/
+func isAttributeOption(x string) bool {
+	for i := 0; i < len(x); i++ {
+		if !isAlnum(rune(x[i])) && x[i] != '-' {
+			return false
+		}
+	}
+
+	return len(x) > 0
+}
+
 /*
 String returns the string representation of the underlying value within the receiver. The return value shall reflect an LDAP descriptor, such as `manager` or `cn`.
 */
@@ -485,6 +706,8 @@ func (r AttributeValue) Compare(x any) bool {
 
 /*
 AV initializes, sets and returns an [AttributeValue] instance in one shot. The input value x shall be a known [BindType] constant, such as [USERDN], OR a raw string value.
+
+Note that x is used as-is. Any unescaped number sign (#) within x, which would be confused with the delimiter of an [AttributeBindTypeOrValue] statement, shall cause [AttributeValue.Valid] to return an error. See the [AVDN] function for a means of escaping such characters.
 */
 func AV(x string) (A AttributeValue) {
 	if len(x) > 0 {
@@ -493,6 +716,74 @@ func AV(x string) (A AttributeValue) {
 	return
 }
 
+/*
+AVDN initializes, sets and returns a distinguished name-valued [AttributeValue] instance. Any unescaped number sign (#) within dn is escaped per RFC 4514 Section 2.4 (e.g.: `\#`), thereby preserving the integrity of the delimiter used in [AttributeBindTypeOrValue] statements.
+
+A bogus [AttributeValue] is returned if dn is zero length.
+*/
+func AVDN(dn string) AttributeValue {
+	var esc string
+	for i := 0; i < len(dn); i++ {
+		if dn[i] == '#' && !escapedAt(dn, i) {
+			esc += `\`
+		}
+		esc += string(dn[i])
+	}
+
+	return AV(esc)
+}
+
+/*
+AVBT initializes, sets and returns a [BindType]-valued [AttributeValue] instance, such as one bearing [USERDN]. A bogus [AttributeValue] is returned if bt is not a known [BindType].
+
+When assigned to an [AttributeBindTypeOrValue] instance, the return value is stored as the [BindType] itself.
+*/
+func AVBT(bt BindType) (A AttributeValue) {
+	if bt.String() != badBT {
+		A = AV(bt.String())
+	}
+	return
+}
+
+/*
+IsZero returns a Boolean value indicative of whether the receiver is nil, or unset.
+*/
+func (r AttributeValue) IsZero() bool {
+	if r.string == nil {
+		return true
+	}
+	return len(*r.string) == 0
+}
+
+/*
+Valid returns an error instance should the receiver be nil, or contain an unescaped number sign (#), which would be confused with the delimiter of an [AttributeBindTypeOrValue] statement.
+*/
+func (r AttributeValue) Valid() error {
+	if r.IsZero() {
+		return nilInstanceErr(r)
+	}
+
+	for i := 0; i < len(*r.string); i++ {
+		if (*r.string)[i] == '#' && !escapedAt(*r.string, i) {
+			return attributeValueDelimErr(r)
+		}
+	}
+
+	return nil
+}
+
+/*
+escapedAt returns a Boolean value indicative of whether the character at index i of x is preceded by an odd number of backslash (\) characters, and is therefore escaped.
+*/
+func escapedAt(x string, i int) bool {
+	var n int
+	for j := i - 1; j >= 0 && x[j] == '\\'; j-- {
+		n++
+	}
+
+	return n%2 == 1
+}
+
 /*
 String returns the string representation of the underlying value within the receiver. The return value should be either an attributeType assertion value, or one (1) of the five (5) possible [BindType] identifiers (e.g.: [USERDN]).
 */
@@ -594,6 +885,109 @@ func (r AttributeTypes) TRM() TargetRuleMethods {
 	return TargetRuleMethods{nil}
 }
 
+/*
+Rule returns a [TargetRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [TargetRule] is returned if op is not permitted for use with the receiver.
+
+See also the [AttributeTypes.TRM] method.
+*/
+func (r AttributeTypes) Rule(op any) TargetRule {
+	return r.TRM().rule(op)
+}
+
+/*
+SetDelimiter controls the delimitation scheme employed by the receiver.
+
+By default, the symbolic OR operator (`||`) is used to join values. This scheme can be set using the [MultivalSymbolDelim] integer constant (0), or when run in niladic fashion. Alternatively, a comma (ASCII #44) may be set using the [MultivalCommaDelim] integer constant (1).
+
+This method has no effect upon instances crafted using the [UAs] function, as LDAP Search URI attribute lists are always comma-delimited.
+*/
+func (r AttributeTypes) SetDelimiter(i ...int) AttributeTypes {
+	setMultivalDelim(r.cast(), i...)
+	return r
+}
+
+/*
+SetCase sets the case normalization mode of the receiver to one of [AttributeCasePreserve], [AttributeCaseLower] or [AttributeCaseRegistered], overriding the [AttributeTypeCaseMode] global variable. [AttributeType] instances already present within the receiver are normalized immediately, while those pushed subsequently are normalized upon push.
+
+Unrecognized modes are ignored.
+*/
+func (r AttributeTypes) SetCase(mode int) AttributeTypes {
+	if r.IsZero() || mode < AttributeCasePreserve || mode > AttributeCaseRegistered {
+		return r
+	}
+
+	_r := r.cast()
+	if _r.Auxiliary() == nil {
+		_r.SetAuxiliary()
+	}
+	_r.Auxiliary().Set(caseKey, mode)
+
+	for i := 0; i < r.Len(); i++ {
+		if at := r.Index(i); !at.IsZero() && *at.string != `*` {
+			_r.Replace(AT(normalizeAttributeTypeCase(*at.string, mode)), i)
+		}
+	}
+
+	return r
+}
+
+/*
+caseMode returns the case normalization mode of the receiver, if set, else the value of the [AttributeTypeCaseMode] global variable.
+*/
+func (r AttributeTypes) caseMode() int {
+	if aux := r.cast().Auxiliary(); aux != nil {
+		if mode, found := aux.Get(caseKey); found {
+			return mode.(int)
+		}
+	}
+
+	return AttributeTypeCaseMode
+}
+
+/*
+SetSchemaChecker sets the [SchemaChecker] to be consulted by the [AttributeTypes.Valid] method of the receiver, overriding the [AttributeSchemaChecker] global variable. A nil checker disables schema checking for the receiver, regardless of the global variable.
+*/
+func (r AttributeTypes) SetSchemaChecker(checker SchemaChecker) AttributeTypes {
+	if r.IsZero() {
+		return r
+	}
+
+	_r := r.cast()
+	if _r.Auxiliary() == nil {
+		_r.SetAuxiliary()
+	}
+	_r.Auxiliary().Set(schemaKey, checker)
+
+	return r
+}
+
+/*
+schemaChecker returns the [SchemaChecker] of the receiver, if set, else the value of the [AttributeSchemaChecker] global variable.
+*/
+func (r AttributeTypes) schemaChecker() SchemaChecker {
+	if aux := r.cast().Auxiliary(); aux != nil {
+		if checker, found := aux.Get(schemaKey); found {
+			sc, _ := checker.(SchemaChecker)
+			return sc
+		}
+	}
+
+	return AttributeSchemaChecker
+}
+
+/*
+unknownAttributeTypes returns the base names of all [AttributeType] instances within the receiver which are not known to checker. The wildcard (`*`) is never checked.
+*/
+func (r AttributeTypes) unknownAttributeTypes(checker SchemaChecker) (unknown []string) {
+	for i := 0; i < r.Len(); i++ {
+		if base := r.Index(i).Base(); len(base) > 0 && base != `*` && !checker.IsKnownAttribute(base) {
+			unknown = append(unknown, base)
+		}
+	}
+
+	return
+}
+
 /*
 setQuoteStyle shall set the receiver instance to the quotation
 scheme defined by integer i.
@@ -664,10 +1058,17 @@ func (r AttributeTypes) Kind() string {
 /*
 Valid returns an instance of error in the event the receiver is in
 an aberrant state.
+
+If a [SchemaChecker] is in effect for the receiver, an error is also
+returned if any [AttributeType] name is unknown to it.
 */
 func (r AttributeTypes) Valid() (err error) {
 	if r.Kind() == `<uninitialized>` {
 		err = nilInstanceErr(r)
+	} else if checker := r.schemaChecker(); checker != nil {
+		if unknown := r.unknownAttributeTypes(checker); len(unknown) > 0 {
+			err = unknownAttributeTypesErr(unknown)
+		}
 	}
 
 	return
@@ -693,6 +1094,55 @@ func (r AttributeTypes) transfer(dest AttributeTypes) {
 	_r.Transfer(_d)
 }
 
+/*
+Sort reorders the receiver's [AttributeType] instances in place, by case-insensitive lexical order, and returns the receiver. This produces a canonical multi-valued expression, useful when comparing against server output.
+*/
+func (r AttributeTypes) Sort() AttributeTypes {
+	sortStack(r.cast(), func(a, b any) bool {
+		A, B := sprintf("%s", a), sprintf("%s", b)
+		if la, lb := lc(A), lc(B); la != lb {
+			return la < lb
+		}
+		return A < B
+	})
+
+	return r
+}
+
+/*
+Complement returns a new instance of [AttributeTypes] containing each of the names within all that are not present within the receiver, in the order in which they were provided. This is the set difference of all less the receiver.
+
+This is useful when converting an exclusionary [TargetAttr] rule into an inclusionary one (or vice versa), given a complete list of [AttributeType] names sourced from the directory schema. For example, the complement of `a || b` given `a`, `b`, `c` and `d` is `c || d`, such that the following [TargetRule] instances are equivalent with regards to that schema:
+
+	( targetattr != "a || b" )
+	( targetattr = "c || d" )
+
+Case is not significant in the matching process, and duplicate names within all are only returned once. If the receiver contains the wildcard (`*`), the return instance shall be empty. The return instance shall be of the same kind as the receiver, or that of [TAs] if the receiver is zero.
+
+A zero instance is returned if any of all is not a valid [AttributeType] name, or is the wildcard.
+*/
+func (r AttributeTypes) Complement(all []string) (c AttributeTypes) {
+	for i := 0; i < len(all); i++ {
+		if all[i] == `*` || AT(all[i]).IsZero() {
+			return
+		}
+	}
+
+	if r.Kind() == `<uri_search_attributes>` {
+		c = UAs()
+	} else {
+		c = TAs()
+	}
+
+	for i := 0; i < len(all); i++ {
+		if !r.Contains(all[i]) && !c.contains(all[i]) {
+			c.Push(all[i])
+		}
+	}
+
+	return
+}
+
 /*
 Pop wraps the [stackage.Stack.Pop] method.
 */
@@ -710,10 +1160,16 @@ Push wraps the [stackage.Stack.Push] method. Valid input types are string and [A
 */
 func (r AttributeTypes) Push(x ...any) AttributeTypes {
 	_r := r.cast()
+	mode := r.caseMode()
 	for i := 0; i < len(x); i++ {
 		switch tv := x[i].(type) {
 		case string:
-			_r.Push(AT(tv))
+			_r.Push(AT(normalizeAttributeTypeCase(tv, mode)))
+		case AttributeType:
+			if mode != AttributeCasePreserve && !tv.IsZero() {
+				tv = AT(normalizeAttributeTypeCase(*tv.string, mode))
+			}
+			_r.Push(tv)
 		default:
 			_r.Push(tv)
 		}
@@ -752,12 +1208,28 @@ func (r AttributeTypes) pushPolicy(x ...any) (err error) {
 /*
 Contains returns a Boolean value indicative of whether value x, if a string or [AttributeType] instance, already resides within the receiver instance.
 
-Case is not significant in the matching process.
+Case is not significant in the matching process. If the receiver contains the wildcard (`*`), any non-zero value x is considered to be a match.
 */
 func (r AttributeTypes) Contains(x any) bool {
+	if r.contains(`*`) {
+		switch tv := x.(type) {
+		case string:
+			return len(tv) > 0
+		case AttributeType:
+			return !tv.IsZero()
+		}
+	}
+
 	return r.contains(x)
 }
 
+/*
+wildcardPlus returns a Boolean value indicative of whether the receiver contains the wildcard (`*`) alongside one (1) or more specific [AttributeType] instances, e.g.: `* || aci`.
+*/
+func (r AttributeTypes) wildcardPlus() bool {
+	return r.Len() > 1 && r.contains(`*`)
+}
+
 /*
 contains is a private method called by AttributeTypes.Contains.
 */
@@ -791,6 +1263,8 @@ TAs returns a freshly initialized instance of [AttributeTypes], configured to st
 Optionally, the caller may choose to submit one (1) or more (valid) instances of the [AttributeType] type (or its string equivalent) during initialization. This is merely a more convenient alternative to separate initialization and push procedures.
 
 Values are automatically delimited using the [stackage.Stack.Symbol] method using the symbolic OR operator (`||`).
+
+The wildcard (`*`) may be combined with specific [AttributeType] names, e.g.: `* || aci`. Products derived from the Netscape Directory Server lineage (e.g.: 389 Directory Server) interpret this as all user attributes plus those named, such as operational attributes not otherwise covered by the wildcard. Other products may reject or misinterpret such lists; see [Instruction.ValidStrict].
 */
 func TAs(x ...any) (a AttributeTypes) {
 	_a := stackOr().
@@ -2,6 +2,7 @@ package aci
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -187,6 +188,74 @@ func ExampleAttributeTypes_Contains() {
 	// Output: Contains 'l': true
 }
 
+func ExampleAttributeTypes_Contains_wildcard() {
+	attrs := TAs(`*`, `aci`)
+	fmt.Printf("%s contains 'nsRoleDN': %t", attrs.Eq(), attrs.Contains(`nsRoleDN`))
+	// Output: ( targetattr = "* || aci" ) contains 'nsRoleDN': true
+}
+
+func ExampleAttributeTypes_Complement() {
+	schema := []string{`cn`, `sn`, `mail`, `userPassword`}
+	excluded := TAs(`userPassword`)
+	fmt.Printf("%s", excluded.Complement(schema).Eq())
+	// Output: ( targetattr = "cn || sn || mail" )
+}
+
+type staticSchema map[string]bool
+
+func (r staticSchema) IsKnownAttribute(name string) bool {
+	return r[lc(name)]
+}
+
+func ExampleAttributeTypes_SetSchemaChecker() {
+	schema := staticSchema{`cn`: true, `sn`: true, `givenname`: true}
+	attrs := TAs(`cn`, `givenNam`).SetSchemaChecker(schema)
+	fmt.Println(attrs.Valid())
+	// Output: Unknown aci.AttributeType name(s) per schema: givenNam
+}
+
+func TestAttributeTypes_SetSchemaChecker(t *testing.T) {
+	defer func() { AttributeSchemaChecker = nil }()
+	schema := staticSchema{`cn`: true, `sn`: true, `usercertificate`: true}
+
+	// no checking by default
+	attrs := TAs(`cn`, `bogus`)
+	if err := attrs.Valid(); err != nil {
+		t.Errorf("%s failed [default]: %v", t.Name(), err)
+		return
+	}
+
+	// global checker; wildcard and options are tolerated
+	AttributeSchemaChecker = schema
+	if err := TAs(`*`, `CN`, `userCertificate;binary`).Valid(); err != nil {
+		t.Errorf("%s failed [global]: %v", t.Name(), err)
+		return
+	} else if err = attrs.Eq().Valid(); err == nil {
+		t.Errorf("%s failed [global]: expected error for %s, got nil", t.Name(), attrs)
+		return
+	}
+
+	trs, err := ParseTargetRules(`( targetattr = "cn || sn || mial" )`)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if err = trs.Index(0).Valid(); err == nil || !strings.Contains(err.Error(), `mial`) {
+		t.Errorf("%s failed [parsed]: expected error naming mial, got %v", t.Name(), err)
+		return
+	}
+
+	i := ACI(`Typo`, TRs(attrs.Eq()), PBR(Allow(ReadAccess), Anyone().Eq()))
+	if errs := ACIs(i).ValidateAll(); len(errs) != 1 {
+		t.Errorf("%s failed [ValidateAll]: want 1 error, got %v", t.Name(), errs)
+		return
+	}
+
+	// instance override disables checking
+	if err = attrs.SetSchemaChecker(nil).Valid(); err != nil {
+		t.Errorf("%s failed [override]: %v", t.Name(), err)
+	}
+}
+
 /*
 This example demonstrates a basic SHA-1 hash comparison between two
 like instances of the receiver's type.
@@ -461,6 +530,15 @@ func ExampleGAT() {
 	// Output: aci.AttributeBindTypeOrValue: owner#SELFDN
 }
 
+/*
+This example demonstrates the creation of an instance of [AttributeBindTypeOrValue] whose value is an LDAP URL describing the members of a dynamic group.
+*/
+func ExampleGAT_url() {
+	atb := GAT(AT(`member`), URL(`ldap:///ou=People,dc=example,dc=com??sub?(objectClass=person)`))
+	fmt.Printf("%s", atb.Eq())
+	// Output: groupattr = "member#ldap:///ou=People,dc=example,dc=com??sub?(objectClass=person)"
+}
+
 /*
 This example demonstrates the creation of an instance of [AttributeBindTypeOrValue].
 
@@ -849,3 +927,303 @@ func testEmptyAttrContext(t *testing.T, kw Keyword, attr AttributeType, attrs At
 
 	return
 }
+
+func ExampleAttributeTypes_SetDelimiter() {
+	attrs := TAs(`cn`, `sn`, `givenName`)
+	fmt.Println(attrs.SetDelimiter(MultivalCommaDelim).Eq())
+	fmt.Println(attrs.SetDelimiter().Eq())
+	// Output: ( targetattr = "cn,sn,givenName" )
+	// ( targetattr = "cn || sn || givenName" )
+}
+
+func ExampleAttributeTypes_SetCase() {
+	RegisterAttributeTypes(`givenName`, `telephoneNumber`)
+
+	attrs := TAs(`CN`, `GIVENNAME`, `telephonenumber`)
+	fmt.Println(attrs.SetCase(AttributeCaseLower).Eq())
+	fmt.Println(attrs.SetCase(AttributeCaseRegistered).Eq())
+	// Output: ( targetattr = "cn || givenname || telephonenumber" )
+	// ( targetattr = "cn || givenName || telephoneNumber" )
+}
+
+func TestAttributeTypes_caseMode(t *testing.T) {
+	// default: case is preserved
+	if got := TAs(`GivenName`).String(); got != `GivenName` {
+		t.Errorf("%s failed: want GivenName, got %s", t.Name(), got)
+		return
+	}
+
+	RegisterAttributeTypes(`givenName`, `bogus attr`)
+	defer func() { AttributeTypeCaseMode = AttributeCasePreserve }()
+
+	AttributeTypeCaseMode = AttributeCaseRegistered
+	attrs := TAs(`GIVENNAME`, AT(`Mail`), `*`)
+	if got := attrs.String(); got != `givenName || Mail || *` {
+		t.Errorf("%s failed: unexpected registered case: %s", t.Name(), got)
+		return
+	}
+
+	// per-stack setting overrides the global
+	attrs = TAs().SetCase(AttributeCaseLower).Push(`Mail`, AT(`GIVENNAME`))
+	if got := attrs.String(); got != `mail || givenname` {
+		t.Errorf("%s failed: unexpected lower case: %s", t.Name(), got)
+		return
+	} else if !attrs.Contains(`MAIL`) {
+		t.Errorf("%s failed: case-insensitive Contains failed", t.Name())
+		return
+	}
+
+	// unknown modes are ignored
+	if got := attrs.SetCase(99).Push(`SN`).String(); got != `mail || givenname || sn` {
+		t.Errorf("%s failed: unexpected result after bogus mode: %s", t.Name(), got)
+		return
+	}
+
+	// parsed target rules honor the global setting
+	AttributeTypeCaseMode = AttributeCaseLower
+	tr, err := ParseTargetRule(`( targetattr = "CN || GivenName" )`)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if got := tr.String(); got != `( targetattr = "cn || givenname" )` {
+		t.Errorf("%s failed: unexpected parsed case: %s", t.Name(), got)
+		return
+	}
+}
+
+func ExampleAttributeType_Options() {
+	at := AT(`userCertificate;binary`)
+	fmt.Println(at.Base(), at.Options(), at.Eq())
+	// Output: userCertificate [binary] ( targetattr = "userCertificate;binary" )
+}
+
+func TestAttributeType_Options(t *testing.T) {
+	at := AT(`cn;lang-EN;phonetic`)
+	if at.String() != `cn;lang-EN;phonetic` || at.Base() != `cn` || len(at.Options()) != 2 || at.Options()[0] != `lang-EN` {
+		t.Errorf("%s failed: unexpected %T: %s (%s %v)", t.Name(), at, at, at.Base(), at.Options())
+		return
+	}
+
+	// options are matched without regard for case
+	if tas := TAs(at); !tas.Contains(`cn;LANG-en;phonetic`) {
+		t.Errorf("%s failed: option case affected matching: %s", t.Name(), tas)
+		return
+	}
+
+	if opts := AT(`cn`).Options(); opts != nil {
+		t.Errorf("%s failed: unexpected options: %v", t.Name(), opts)
+		return
+	}
+
+	for _, bogus := range []string{`cn;`, `cn;;binary`, `;binary`, `cn;bin_ary`, `1cn;binary`} {
+		if at = AT(bogus); !at.IsZero() {
+			t.Errorf("%s failed: bogus %T accepted: %s", t.Name(), at, bogus)
+			return
+		}
+	}
+
+	raw := `( targetattr = "userCertificate;binary || cn;lang-en" )`
+	tr, err := ParseTargetRule(raw)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if tr.String() != raw {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), raw, tr)
+		return
+	}
+
+	RegisterAttributeTypes(`userCertificate`)
+	attrs := TAs(`USERCERTIFICATE;binary`).SetCase(AttributeCaseRegistered)
+	if got := attrs.String(); got != `userCertificate;binary` {
+		t.Errorf("%s failed: unexpected registered case: %s", t.Name(), got)
+		return
+	}
+}
+
+func ExampleAVDN() {
+	av := AVDN(`cn=Team #1,ou=Groups,dc=example,dc=com`)
+	fmt.Println(av, av.Valid() == nil)
+	// Output: cn=Team \#1,ou=Groups,dc=example,dc=com true
+}
+
+func ExampleAttributeValue_Valid() {
+	fmt.Println(AV(`a#b`).Valid())
+	// Output: Invalid AttributeValue instance: unescaped '#' delimiter found in 'a#b'
+}
+
+func TestAttributeValue_Valid(t *testing.T) {
+	for idx, tc := range []struct {
+		av    AttributeValue
+		valid bool
+	}{
+		{AV(`FALSE`), true},
+		{AV(`a\#b`), true},
+		{AV(`a#b`), false},
+		{AV(`a\\#b`), false},
+		{AVDN(`a\\#b`), true},
+		{AVDN(`a\#b`), true},
+		{AVBT(GROUPDN), true},
+		{AVBT(BindType(0x0)), false},
+		{AV(``), false},
+	} {
+		if err := tc.av.Valid(); (err == nil) != tc.valid {
+			t.Errorf("%s[%d] failed: %s: want valid %t, got %v", t.Name(), idx, tc.av, tc.valid, err)
+			return
+		}
+	}
+
+	// flagged values must not produce usable userattr rules
+	if br := UAT(AT(`manager`), AV(`a#b`)).Eq(); !br.IsZero() {
+		t.Errorf("%s failed: expected bogus %T, got %s", t.Name(), br, br)
+		return
+	} else if _, err := ParseBindRules(`userattr = "manager#a#b"`); err == nil {
+		t.Errorf("%s failed: expected parse error, got nil", t.Name())
+		return
+	}
+
+	// bind type values are stored as BindType instances
+	abtv := UAT(AT(`manager`), AVBT(USERDN))
+	if _, ok := abtv.atbtv[1].(BindType); !ok {
+		t.Errorf("%s failed: want %T, got %T", t.Name(), USERDN, abtv.atbtv[1])
+	}
+}
+
+func TestAttributeTypes_wildcardPlus(t *testing.T) {
+	for idx, raw := range []string{
+		`( targetattr = "* || aci" )`,
+		`( targetattr = "aci || *" )`,
+		`( targetattr != "* || aci || nsRoleDN" )`,
+	} {
+		tr, err := ParseTargetRules(raw)
+		if err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		} else if got := tr.String(); got != raw {
+			t.Errorf("%s[%d] failed: want %s, got %s", t.Name(), idx, raw, got)
+			return
+		}
+
+		ats, ok := tr.Index(0).Expression().(AttributeTypes)
+		if !ok {
+			t.Errorf("%s[%d] failed: want %T, got %T", t.Name(), idx, ats, tr.Index(0).Expression())
+			return
+		} else if !ats.wildcardPlus() || !ats.Contains(`cn`) || !ats.Contains(AT(`aci`)) {
+			t.Errorf("%s[%d] failed: wildcard not honored for %s", t.Name(), idx, ats)
+			return
+		}
+	}
+
+	if ats := TAs(`cn`, `aci`); ats.wildcardPlus() || ats.Contains(`sn`) || ats.Contains(``) {
+		t.Errorf("%s failed: unexpected wildcard match", t.Name())
+	}
+}
+
+func ExampleAttributeTypes_Sort() {
+	attrs := TAs(`sn`, `cn`, `givenName`, `Mail`)
+	fmt.Printf("%s", attrs.Sort())
+	// Output: cn || givenName || Mail || sn
+}
+
+func TestAttributeTypes_Sort(t *testing.T) {
+	attrs := TAs(`uid`, `*`, `aci`, `cn;lang-en`, `cn`)
+	want := `( targetattr = "* || aci || cn || cn;lang-en || uid" )`
+	if got := attrs.Sort().Eq().String(); got != want {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), want, got)
+		return
+	} else if got = attrs.Index(0).String(); got != `*` {
+		t.Errorf("%s failed: receiver not sorted in place; got %s", t.Name(), got)
+	}
+}
+
+func TestAttributeTypes_Complement(t *testing.T) {
+	schema := []string{`cn`, `sn`, `mail`, `CN`, `telephoneNumber`}
+
+	for idx, obj := range []struct {
+		r    AttributeTypes
+		want string
+	}{
+		{TAs(`cn`, `mail`), `sn || telephoneNumber`},
+		{TAs(`SN`), `cn || mail || telephoneNumber`},
+		{TAs(), `cn || sn || mail || telephoneNumber`},
+		{AttributeTypes{}, `cn || sn || mail || telephoneNumber`},
+		{TAs(`*`), ``},
+		{UAs(`cn`), `sn,mail,telephoneNumber`},
+	} {
+		if got := obj.r.Complement(schema).String(); got != obj.want {
+			t.Errorf("%s[%d] failed:\nwant: '%s'\ngot:  '%s'", t.Name(), idx, obj.want, got)
+			return
+		}
+	}
+
+	// complementing twice yields the original inclusion list
+	inc := TAs(`cn`, `mail`)
+	if got := inc.Complement(schema).Complement(schema); got.String() != `cn || mail` {
+		t.Errorf("%s failed: round trip produced %s", t.Name(), got)
+		return
+	}
+
+	for idx, bogus := range [][]string{
+		{`cn`, `*`},
+		{`cn`, `bogus attr`},
+		{``},
+	} {
+		if c := inc.Complement(bogus); !c.IsZero() {
+			t.Errorf("%s[bogus %d] failed: expected zero %T, got %s", t.Name(), idx, c, c)
+			return
+		}
+	}
+}
+
+func TestAttributeBindTypeOrValue_url(t *testing.T) {
+	const url = `ldap:///ou=People,dc=example,dc=com??sub?(objectClass=person)`
+
+	for idx, raw := range []string{
+		`groupattr = "member#` + url + `"`,
+		`groupattr != "uniqueMember#ldap:///ou=Groups,dc=example,dc=com??one?(&(objectClass=person)(l=Austin))"`,
+	} {
+		br, err := ParseBindRules(raw)
+		if err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		} else if got := br.String(); got != raw {
+			t.Errorf("%s[%d] failed:\nwant: %s\ngot:  %s", t.Name(), idx, raw, got)
+			return
+		}
+	}
+
+	// hostport is verified, then discarded
+	var atb AttributeBindTypeOrValue
+	if err := atb.Parse(`member#ldap://ds.example.com:389/ou=People,dc=example,dc=com??sub?(objectClass=person)`, BindGAT); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if got := atb.String(); got != `member#`+url {
+		t.Errorf("%s failed: want %s, got %s", t.Name(), `member#`+url, got)
+		return
+	} else if atb.Keyword() != BindGAT {
+		t.Errorf("%s failed: want %s, got %s", t.Name(), BindGAT, atb.Keyword())
+		return
+	}
+
+	for idx, raw := range []string{
+		`member#ldap:///ou=People,dc=example,dc=com??sub?(objectClass=person`,
+		`member#ldap:///ou=People,dc=example,dc=com??bogus?(objectClass=person)`,
+		`member#ldap://bad host/ou=People,dc=example,dc=com??sub?(objectClass=person)`,
+		`member#ldap:///ou=People,dc=example,dc=com?owner#GROUPDN`,
+	} {
+		if err := atb.Parse(raw, BindGAT); err == nil {
+			t.Errorf("%s[%d] failed: expected error for %s, got nil", t.Name(), idx, raw)
+			return
+		}
+	}
+
+	// URL values are not permitted for userattr
+	if uat := UAT(AT(`member`), URL(url)); uat.Valid() == nil || !uat.Eq().IsZero() {
+		t.Errorf("%s failed: expected error for %s URL value", t.Name(), BindUAT)
+		return
+	}
+
+	// a bogus URL never qualifies
+	if gat := GAT(AT(`member`), `ldap:///bogus`); gat.Valid() == nil {
+		t.Errorf("%s failed: expected error for bogus URL value", t.Name())
+	}
+}
@@ -9,6 +9,13 @@ var (
 	badBindRules BindRules
 )
 
+/*
+BooleanWordLowerCase allows control over the case folding of the Boolean WORD operators (AND, OR and AND NOT) found within the string representation of [BindRules] instances assembled through the [And], [Or] and [Not] functions, including those produced during parsing.
+
+A value of true shall force lowercase operators (e.g.: `and not`), while a value of false (default) forces uppercase operators (e.g.: `AND NOT`). As with [StackPadding], this value is read upon construction; the [BindRules.Fold] method may be used to alter the case of an individual, existing instance. Note that the case of a nested NOT operator follows that of the AND operator of the enclosing stack.
+*/
+var BooleanWordLowerCase bool
+
 /*
 BindRuleMethods contains one (1) or more instances of [BindRuleMethod], representing a particular [BindRule] "builder" method for execution by the caller.
 
@@ -55,6 +62,20 @@ func (r BindRuleMethods) Index(idx any) (ComparisonOperator, BindRuleMethod) {
 	return r.index(idx)
 }
 
+/*
+rule is a private method called by the Rule method extended through all eligible types. The [BindRuleMethod] indexed by op is executed, and its return value is verified to bear a [ComparisonOperator] permitted for use with its [Keyword].
+*/
+func (r BindRuleMethods) rule(op any) (b BindRule) {
+	b = badBindRule
+	if cop, meth := r.index(op); meth != nil {
+		if br := meth(); !br.IsZero() && keywordAllowsComparisonOperator(br.Keyword(), cop) {
+			b = br
+		}
+	}
+
+	return
+}
+
 /*
 index is a private method called by BindRuleMethods.Index.
 */
@@ -142,6 +163,10 @@ func newBindRule(kw, op, ex any) (b BindRule) {
 		SetID(bindRuleID).
 		NoPadding(!RulePadding)
 
+	if MultivalQuoteStyle != MultivalOuterQuotes {
+		b.SetQuoteStyle(MultivalQuoteStyle)
+	}
+
 	return
 }
 
@@ -217,6 +242,23 @@ func (r BindRuleMethods) Len() int {
 	return len((*r.bindRuleFuncMap))
 }
 
+/*
+Names returns the [ComparisonOperator] contexts (e.g.: `Eq`, `Ne`) available within the receiver, in ascending operator order. This allows the [BindRule] types supported by a given value to be introspected without iterating [BindRuleMethods.Index]. A nil slice is returned if the receiver is nil, or unset.
+*/
+func (r BindRuleMethods) Names() (names []string) {
+	if r.IsZero() {
+		return
+	}
+
+	for cop := Eq; cop <= Ge; cop++ {
+		if _, found := (*r.bindRuleFuncMap)[cop]; found {
+			names = append(names, cop.Context())
+		}
+	}
+
+	return
+}
+
 /*
 BindRuleMethod is the closure signature for methods used to build new instances of [BindRule].
 
@@ -313,7 +355,17 @@ func (r BindRule) Valid() (err error) {
 		return
 	}
 
-	err = r.cast().Valid()
+	if err = r.cast().Valid(); err != nil {
+		return
+	}
+
+	switch kw := r.Keyword(); kw {
+	case BindSSF:
+		err = validSSFExpression(r.Expression())
+	case BindUDN, BindGDN, BindRDN, BindUAT, BindGAT:
+		err = validURIExpression(r.Expression(), kw.(BindKeyword))
+	}
+
 	return
 }
 
@@ -349,6 +401,37 @@ func (r BindRule) String() string {
 	return r.cast().String()
 }
 
+/*
+StringBare returns the string representation of the receiver instance without any enclosing parenthetical characters, regardless of whether [BindRule.Paren] was invoked, e.g.:
+
+	ssf >= "128"
+
+This is useful when composing custom output. The parenthetical state of the receiver is not altered.
+*/
+func (r BindRule) StringBare() string {
+	if r.IsZero() {
+		return ``
+	}
+
+	br := r.cast()
+	return unparen(br.String(), br.IsParen())
+}
+
+/*
+Describe returns a human-readable representation of the receiver instance in which the [ComparisonOperator] is rendered using its description (see [ComparisonOperator.Description]), e.g.:
+
+	userdn [Equal To] "ldap:///uid=jesse,ou=People,dc=example,dc=com"
+
+This format is intended for logging and debugging purposes only; it is not valid ACIv3 syntax. See [BindRule.String] for the wire form.
+*/
+func (r BindRule) Describe() string {
+	if r.IsZero() {
+		return ``
+	}
+
+	return describeRule(r.Keyword(), r.Operator(), r.StringBare())
+}
+
 /*
 NoPadding wraps the [stackage.Condition.NoPadding] method.
 */
@@ -423,7 +506,8 @@ func And(x ...any) (b BindRules) {
 	_b := stackAnd().
 		SetID(bindRuleID).
 		SetCategory(`and`).
-		NoPadding(!StackPadding)
+		NoPadding(!StackPadding).
+		Fold(BooleanWordLowerCase)
 
 	// cast _a as a proper BindRules instance
 	// (b). We do it this way to gain access
@@ -462,7 +546,8 @@ func Or(x ...any) (b BindRules) {
 	_b := stackOr().
 		SetID(bindRuleID).
 		SetCategory(`or`).
-		NoPadding(!StackPadding)
+		NoPadding(!StackPadding).
+		Fold(BooleanWordLowerCase)
 
 	// cast _a as a proper BindRules instance
 	// (b). We do it this way to gain access
@@ -501,7 +586,8 @@ func Not(x ...any) (b BindRules) {
 	_b := stackNot().
 		SetID(bindRuleID).
 		SetCategory(`not`).
-		NoPadding(!StackPadding)
+		NoPadding(!StackPadding).
+		Fold(BooleanWordLowerCase)
 
 	// cast _a as a proper BindRules instance
 	// (b). We do it this way to gain access
@@ -705,7 +791,11 @@ func (r BindRule) SetKeyword(kw any) BindRule {
 }
 
 /*
-SetOperator wraps the [stackage.Condition.SetOperator] method.
+SetOperator wraps the [stackage.Condition.SetOperator] method. Valid input types are [ComparisonOperator] or its string value equivalent (e.g.: `>=` for Ge).
+
+The operator is only set if it is permitted for use with the [BindKeyword] of the receiver, thus the [BindKeyword] should be set first. For example, the operator of an existing `ssf` [BindRule] may be changed from Eq to Ge, whereas a `userdn` [BindRule] may only bear Eq or Ne. Operators that are unknown or not permitted are silently ignored, leaving the receiver unchanged.
+
+As the receiver is a pointer-based alias type, the change is visible through all copies of the receiver.
 */
 func (r BindRule) SetOperator(op any) BindRule {
 	var cop ComparisonOperator
@@ -714,31 +804,32 @@ func (r BindRule) SetOperator(op any) BindRule {
 		cop = matchCOP(tv)
 	case ComparisonOperator:
 		cop = tv
+	default:
+		// bogus operator type
+		return r
+	}
+
+	// operator not known? bail out
+	if cop == ComparisonOperator(0) {
+		return r
 	}
 
 	// ALL Target and Bind rules accept Eq,
 	// so only scrutinize the operator if
 	// it is something *other than* that.
 	if cop != Eq {
-		if !keywordAllowsComparisonOperator(r.Keyword(), op) {
+		if !keywordAllowsComparisonOperator(r.Keyword(), cop) {
 			return r
 		}
 	}
 
-	// operator not known? bail out
-	if cop == ComparisonOperator(0) {
-		return r
-	}
-
 	// not initialized? bail out
-	if !r.cast().IsInit() {
-		return r
+	if r.cast().IsInit() {
+		// cast to stackage.Condition and
+		// set operator value.
+		r.cast().SetOperator(cop)
 	}
 
-	// cast to stackage.Condition and
-	// set operator value.
-	r.cast().SetOperator(cop)
-
 	return r
 }
 
@@ -804,10 +895,48 @@ func (r BindRule) SetQuoteStyle(style int) BindRule {
 /*
 String is a stringer method that returns the string representation of the receiver instance.
 
-This method wraps the [stackage.Stack.String] method.
+This method wraps the [stackage.Stack.String] method. The case of any nested NOT operator is made to agree with that of the lowercase AND or OR operator that precedes it (see [BindRules.Fold] and [BooleanWordLowerCase]).
 */
 func (r BindRules) String() string {
-	return r.cast().String()
+	return foldNegation(r.cast().String())
+}
+
+/*
+foldNegation lowercases each unquoted `NOT` WORD operator which immediately follows a lowercase `and` or `or` WORD operator within x. This compensates for [stackage], which always renders the operator of a nested NOT stack in uppercase, regardless of case folding.
+*/
+func foldNegation(x string) string {
+	if !contains(x, `NOT`) {
+		return x
+	}
+
+	b := []byte(x)
+	var quoted bool
+	for i := 0; i < len(b); i++ {
+		switch {
+		case quoted && b[i] == '\\':
+			i++
+		case b[i] == '"':
+			quoted = !quoted
+		case !quoted && followsLowerWord(b, i) && hasPfx(string(b[i:]), `NOT`) &&
+			(i+3 == len(b) || !isWordChar(b[i+3])):
+			copy(b[i:], `not`)
+		}
+	}
+
+	return string(b)
+}
+
+/*
+followsLowerWord returns a Boolean value indicative of whether index i of b is immediately preceded by a lowercase `and` or `or` WORD operator and a single space.
+*/
+func followsLowerWord(b []byte, i int) bool {
+	for _, word := range []string{`and `, `or `} {
+		if j := i - len(word); j >= 0 && string(b[j:i]) == word {
+			return j == 0 || !isWordChar(b[j-1])
+		}
+	}
+
+	return false
 }
 
 /*
@@ -861,6 +990,60 @@ func (r BindRules) IsNesting() bool {
 	return r.cast().IsNesting()
 }
 
+/*
+Depth returns the maximum nesting depth of the receiver. A stack containing only [BindRule] instances -- a "flat" rule -- bears a depth of one (1), while each nested [BindRules] stack (e.g.: an AND within an OR, or an enclosed NOT) adds one (1) level. Zero (0) is returned if the receiver is nil, or unset.
+
+This is useful for complexity budgeting, as some directory products limit expression nesting. See also the [MaxBindRuleDepth] global variable.
+*/
+func (r BindRules) Depth() int {
+	return bindContextDepth(r)
+}
+
+/*
+bindContextDepth returns the maximum nesting depth of the input [BindContext]. A lone [BindRule] bears a depth of one (1).
+*/
+func bindContextDepth(b BindContext) (depth int) {
+	switch tv := b.(type) {
+	case BindRule:
+		if !tv.IsZero() {
+			depth = 1
+		}
+	case BindRules:
+		if tv.IsZero() {
+			return
+		}
+
+		depth = 1
+		for i := 0; i < tv.Len(); i++ {
+			if sub, ok := tv.Index(i).(BindRules); ok {
+				if d := bindContextDepth(sub) + 1; d > depth {
+					depth = d
+				}
+			}
+		}
+	}
+
+	return
+}
+
+/*
+bindRulesOf returns all [BindRule] instances found within the input [BindContext], at any depth, in the order in which they appear.
+*/
+func bindRulesOf(b BindContext) (rules []BindRule) {
+	switch tv := b.(type) {
+	case BindRule:
+		if !tv.IsZero() {
+			rules = append(rules, tv)
+		}
+	case BindRules:
+		for i := 0; i < tv.Len(); i++ {
+			rules = append(rules, bindRulesOf(tv.Index(i))...)
+		}
+	}
+
+	return
+}
+
 /*
 Keyword wraps the [stackage.Stack.Category] method and resolves the raw value into a [BindKeyword]. Failure to do so will return a bogus [Keyword].
 */
@@ -978,6 +1161,207 @@ func (r BindRules) Fold(state ...bool) BindRules {
 	return r
 }
 
+/*
+Invert returns a new instance of [BindRule] bearing the same [BindKeyword] and expression value as the receiver, but with the inverse [ComparisonOperator] (see [ComparisonOperator.Inverse]). The parenthetical state of the receiver is preserved, and the receiver is not modified.
+
+Eq and Ne may always be inverted. The ordering operators (Lt, Le, Gt and Ge) may only be inverted for those [BindKeyword] contexts that permit them, namely [BindSSF] and [BindToD].
+
+A bogus [BindRule] is returned if the receiver is zero, or if the inverted [ComparisonOperator] is not permitted for use with the [BindKeyword] of the receiver.
+*/
+func (r BindRule) Invert() (i BindRule) {
+	if r.IsZero() {
+		return
+	}
+
+	kw, op := r.Keyword(), r.Operator().Inverse()
+	if keywordAllowsComparisonOperator(kw, op) {
+		i = BR(kw, op, r.Expression()).Paren(r.IsParen())
+	}
+
+	return
+}
+
+/*
+Negate returns a new instance of [BindRules] that expresses the logical negation of the receiver, making it suitable for use in crafting the deny counterpart of an allow rule, or vice versa. The receiver is not modified.
+
+Negation is achieved through De Morgan's laws rather than through a wrapping NOT stack, as a standalone NOT stack has no valid ACIv3 string representation:
+
+  - An AND stack becomes an OR stack of negated members
+  - An OR stack becomes an AND stack of negated members
+  - A NOT stack becomes an OR stack of its (un-negated) members
+  - A [BindRule] is negated through inversion of its [ComparisonOperator]: Eq becomes Ne, Lt becomes Ge, Gt becomes Le, and vice versa
+
+The parenthetical state of the receiver is preserved, while nested stacks are always parenthesized to retain the intended precedence. A bogus [BindRules] instance is returned if the receiver is zero or invalid, or if any [BindRule] within cannot be negated.
+*/
+func (r BindRules) Negate() (n BindRules) {
+	if r.IsZero() || r.Valid() != nil {
+		return
+	}
+
+	n = negateBindRules(r)
+	return
+}
+
+/*
+negateBindContext is a private function called by negateBindRules.
+*/
+func negateBindContext(ctx BindContext) (n BindContext, ok bool) {
+	switch tv := ctx.(type) {
+	case BindRule:
+		if inv := tv.Invert(); !inv.IsZero() {
+			n, ok = inv, true
+		}
+	case BindRules:
+		var neg BindRules
+		if neg = negateBindRules(tv); !neg.IsZero() {
+			n, ok = neg.Paren(true), true
+		}
+	}
+
+	return
+}
+
+/*
+negateBindRules is a private function called by BindRules.Negate, as well as by negateBindContext for nested [BindRules] instances.
+*/
+func negateBindRules(r BindRules) (n BindRules) {
+	switch lc(r.Category()) {
+	case `and`:
+		n = Or()
+	case `or`:
+		n = And()
+	case `not`:
+		// the members of a NOT stack are
+		// already negated; simply OR them.
+		n = Or()
+		for i := 0; i < r.Len(); i++ {
+			n.Push(r.Index(i))
+		}
+		return n.Paren(r.IsParen())
+	default:
+		return
+	}
+
+	for i := 0; i < r.Len(); i++ {
+		neg, ok := negateBindContext(r.Index(i))
+		if !ok {
+			return BindRules{}
+		}
+		n.Push(neg)
+	}
+
+	return n.Paren(r.IsParen())
+}
+
+/*
+Simplify returns a new instance of [BindRules] that is logically equivalent to the receiver, but which bears no redundant structure. The receiver is not modified. Specifically:
+
+  - AND and OR stacks bearing a single member are collapsed into that member
+  - AND and OR stacks nested within a stack of the same category are merged into their parent, e.g.: "A AND (B AND C)" becomes "A AND B AND C"
+  - Parentheses are removed from all [BindRule] members, as a single [BindRule] never requires them
+  - Nested stacks of a differing category -- as well as stacks within NOT stacks -- are always parenthesized, as their precedence would otherwise be ambiguous
+
+NOT stacks are never collapsed or merged, thus negation boundaries are preserved. The parenthetical state of the receiver is retained by the return instance. If the receiver collapses to a single [BindRule], it is returned as the sole member of a new stack of the receiver's category.
+
+A bogus [BindRules] instance is returned if the receiver is zero, or if its category is not AND, OR or NOT.
+*/
+func (r BindRules) Simplify() (s BindRules) {
+	if r.IsZero() {
+		return
+	}
+
+	switch tv := simplifyBindRules(r).(type) {
+	case BindRules:
+		s = tv
+	case BindRule:
+		s, _ = newBindRulesLike(r)
+		s.Push(tv)
+	}
+
+	if !s.IsZero() {
+		s.Paren(r.IsParen())
+	}
+
+	return
+}
+
+/*
+newBindRulesLike returns a new, empty [BindRules] instance bearing the same Boolean category as r, alongside a Boolean value indicative of success.
+*/
+func newBindRulesLike(r BindRules) (n BindRules, ok bool) {
+	ok = true
+	switch lc(r.Category()) {
+	case `and`:
+		n = And()
+	case `or`:
+		n = Or()
+	case `not`:
+		n = Not()
+	default:
+		ok = false
+	}
+
+	return
+}
+
+/*
+simplifyBindRules is a private function called by BindRules.Simplify, as well as by simplifyBindContext for nested [BindRules] instances. The return value is either a [BindRule] (if r collapsed) or a [BindRules] instance.
+*/
+func simplifyBindRules(r BindRules) BindContext {
+	n, ok := newBindRulesLike(r)
+	if !ok {
+		return badBindRules
+	}
+
+	cat := lc(r.Category())
+	for i := 0; i < r.Len(); i++ {
+		member := r.Index(i)
+		if member == nil || member.IsZero() {
+			continue
+		}
+
+		switch tv := simplifyBindContext(member).(type) {
+		case BindRule:
+			n.Push(tv)
+		case BindRules:
+			if sub := lc(tv.Category()); cat != `not` && sub == cat {
+				for j := 0; j < tv.Len(); j++ {
+					n.Push(tv.Index(j))
+				}
+			} else {
+				n.Push(tv.Paren(sub != `not` || cat == `not`))
+			}
+		}
+	}
+
+	if cat != `not` && n.Len() == 1 {
+		return n.Index(0)
+	}
+
+	return n
+}
+
+/*
+simplifyBindContext returns a simplified copy of b. A [BindRule] is copied without parentheses (see copyBindRule), while a [BindRules] instance is processed by simplifyBindRules.
+*/
+func simplifyBindContext(b BindContext) BindContext {
+	switch tv := b.(type) {
+	case BindRule:
+		return copyBindRule(tv)
+	case BindRules:
+		return simplifyBindRules(tv)
+	}
+
+	return b
+}
+
+/*
+copyBindRule returns an unparenthesized copy of b (see copyCondition). The [BR] function is not used, as it would impose the package defaults -- such as [RulePadding] and [MultivalQuoteStyle] -- upon the copy, and possibly upon the (shared) expression value as well.
+*/
+func copyBindRule(b BindRule) BindRule {
+	return BindRule(copyCondition(b.cast()).Paren(false))
+}
+
 /*
 insert wraps the [stackage.Stack.Insert] method.
 */
@@ -1045,9 +1429,13 @@ func (r BindRules) pushPolicy(x ...any) (err error) {
 			err = pushErrorNilOrZero(r, tv, matchBKW(r.Category()), err)
 		}
 
-		if tv.Keyword() != nil {
-			err = badPTBRuleKeywordErr(tv, `bind`, `bindkeyword`, tv.Keyword())
-			if matchBKW(tv.Keyword().String()) != BindKeyword(0x0) {
+		// only construct an error if the keyword
+		// cannot be resolved, sparing allocations
+		// in the (far more common) success case.
+		if kw := tv.Keyword(); kw != nil {
+			if matchBKW(kw.String()) == BindKeyword(0x0) {
+				err = badPTBRuleKeywordErr(tv, `bind`, `bindkeyword`, kw)
+			} else {
 				err = nil
 			}
 		}
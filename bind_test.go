@@ -2,6 +2,7 @@ package aci
 
 import (
 	"fmt"
+	"sync"
 	"testing"
 )
 
@@ -403,6 +404,36 @@ func ExampleBindRuleMethods_Len() {
 	// Output: There are 6 available aci.BindRuleMethod instances for creating aci.SecurityStrengthFactor BindRules
 }
 
+func ExampleBindRuleMethods_Names() {
+	var ssf SecurityStrengthFactor
+	fmt.Printf("%v", ssf.BRM().Names())
+	// Output: [Eq Ne Lt Gt Le Ge]
+}
+
+func TestBindRuleMethods_Names(t *testing.T) {
+	for idx, tc := range []struct {
+		brm  BindRuleMethods
+		want string
+	}{
+		{UDN(`uid=jesse,ou=People,dc=example,dc=com`).BRM(), `[Eq Ne]`},
+		{SSF(128).BRM(), `[Eq Ne Lt Gt Le Ge]`},
+		{BindRuleMethods{}, `[]`},
+	} {
+		if got := fmt.Sprintf("%v", tc.brm.Names()); got != tc.want {
+			t.Errorf("%s[%d] failed: want %s, got %s", t.Name(), idx, tc.want, got)
+			return
+		}
+
+		// every name must resolve through Index
+		for _, name := range tc.brm.Names() {
+			if cop, meth := tc.brm.Index(name); cop.Context() != name || meth == nil {
+				t.Errorf("%s[%d] failed: %s did not resolve", t.Name(), idx, name)
+				return
+			}
+		}
+	}
+}
+
 func ExampleBindRuleMethod() {
 	ssf := SSF(256)
 	brm := ssf.BRM()
@@ -1048,3 +1079,371 @@ func ExampleBindRule_Len() {
 	fmt.Printf("%T.Len: %d", br, br.Len())
 	// Output: aci.BindRule.Len: 1
 }
+
+func ExampleBindRules_Negate() {
+	allow := And(
+		UDN(`uid=jesse,ou=People,dc=example,dc=com`).Eq(),
+		ToD(`0800`).Ge(),
+	).Paren()
+
+	fmt.Println(allow.Negate())
+	// Output: ( userdn != "ldap:///uid=jesse,ou=People,dc=example,dc=com" OR timeofday < "0800" )
+}
+
+func TestBindRules_Negate(t *testing.T) {
+	orig := And(
+		UDN(`uid=jesse,ou=People,dc=example,dc=com`).Eq(),
+		Or(ToD(`0800`).Ge(), SSF(128).Lt()).Paren(),
+		Not(GDN(`cn=admins,ou=Groups,dc=example,dc=com`).Eq()),
+	).Paren()
+	want := orig.String()
+
+	neg := orig.Negate()
+	if err := neg.Valid(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if !eq(neg.Category(), `or`) || !neg.IsParen() || neg.Len() != orig.Len() {
+		t.Errorf("%s failed: unexpected negation: %s", t.Name(), neg)
+		return
+	} else if orig.String() != want {
+		t.Errorf("%s failed: receiver was modified: %s", t.Name(), orig)
+		return
+	}
+
+	double := neg.Negate()
+	if double.Index(0).String() != orig.Index(0).String() ||
+		double.Index(1).String() != orig.Index(1).String() {
+		t.Errorf("%s failed: double negation mismatch:\nwant: %s\ngot:  %s", t.Name(), orig, double)
+		return
+	}
+
+	var zero BindRules
+	if !zero.Negate().IsZero() {
+		t.Errorf("%s failed: non-zero negation of zero %T", t.Name(), zero)
+		return
+	}
+}
+
+func TestBindRule_StringBare_concurrent(t *testing.T) {
+	br := SSF(128).Ge().Paren()
+	want := br.String()
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 16)
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_ = br.StringBare()
+			_ = br.Describe()
+		}()
+		go func() {
+			defer wg.Done()
+			if got := br.String(); got != want {
+				errs <- got
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for got := range errs {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+}
+
+func ExampleBindRule_StringBare() {
+	br := SSF(128).Ge().Paren()
+
+	fmt.Println(br.StringBare())
+	fmt.Println(br)
+	// Output:
+	// ssf >= "128"
+	// ( ssf >= "128" )
+}
+
+func ExampleBindRule_Invert() {
+	fmt.Println(SSF(128).Ge().Invert())
+	// Output: ssf < "128"
+}
+
+func TestBindRule_Invert(t *testing.T) {
+	for idx, tc := range []struct {
+		rule BindRule
+		want string
+	}{
+		{UDN(`uid=jesse,ou=People,dc=example,dc=com`).Eq(), `userdn != "ldap:///uid=jesse,ou=People,dc=example,dc=com"`},
+		{SASL.Ne(), `authmethod = "SASL"`},
+		{ToD(`0800`).Gt().Paren(), `( timeofday <= "0800" )`},
+		{SSF(56).Le(), `ssf > "56"`},
+	} {
+		orig := tc.rule.String()
+		if got := tc.rule.Invert().String(); got != tc.want {
+			t.Errorf("%s[%d] failed: want %s, got %s", t.Name(), idx, tc.want, got)
+			return
+		} else if tc.rule.String() != orig {
+			t.Errorf("%s[%d] failed: receiver was modified", t.Name(), idx)
+			return
+		}
+	}
+
+	var zero BindRule
+	if !zero.Invert().IsZero() {
+		t.Errorf("%s failed: expected zero %T", t.Name(), zero)
+	}
+}
+
+func ExampleSecurityStrengthFactor_Rule() {
+	fmt.Println(SSF(128).Rule(`>=`))
+	// Output: ssf >= "128"
+}
+
+func TestBindRule_byOperator(t *testing.T) {
+	for idx, tc := range []struct {
+		rule BindRule
+		want string
+	}{
+		{SSF(128).Rule(Ge), `ssf >= "128"`},
+		{SSF(128).Rule(`Less Than`), `ssf < "128"`},
+		{ToD(`1700`).Rule(`le`), `timeofday <= "1700"`},
+		{UDN(`uid=jesse,ou=People,dc=example,dc=com`).Rule(`!=`), `userdn != "ldap:///uid=jesse,ou=People,dc=example,dc=com"`},
+		{IP(`192.168.*`).Rule(int(Eq)), `ip = "192.168.*"`},
+		{SASL.Rule(`Ne`), `authmethod != "SASL"`},
+		{UDN(`uid=jesse,ou=People,dc=example,dc=com`).Rule(Ge), ``},
+		{SSF(128).Rule(`~=`), ``},
+		{SSF(128).Rule(nil), ``},
+	} {
+		if got := tc.rule.String(); got != tc.want {
+			t.Errorf("%s[%d] failed: want '%s', got '%s'", t.Name(), idx, tc.want, got)
+			return
+		}
+	}
+}
+
+func TestBindRule_SetOperator(t *testing.T) {
+	br := UDN(`uid=jesse,ou=People,dc=example,dc=com`).Eq()
+	if br.SetOperator(`!=`); br.Operator() != Ne {
+		t.Errorf("%s failed: want %s, got %s", t.Name(), Ne, br.Operator())
+		return
+	}
+
+	// ordering operators are not permitted for userdn
+	for _, op := range []any{Ge, `<`, 2, `~=`, nil} {
+		if br.SetOperator(op); br.Operator() != Ne {
+			t.Errorf("%s failed: operator %v was accepted; got %s", t.Name(), op, br.Operator())
+			return
+		}
+	}
+
+	ssf := SSF(128).Eq()
+	if ssf.SetOperator(Ge); ssf.String() != `ssf >= "128"` {
+		t.Errorf("%s failed: unexpected result: %s", t.Name(), ssf)
+		return
+	}
+}
+
+func TestBR(t *testing.T) {
+	// assembly from parsed tokens must match
+	// the type-extended equivalent
+	br := BR(`ssf`, `>=`, SSF(128))
+	if want := SSF(128).Ge(); br.String() != want.String() {
+		t.Errorf("%s failed: want %s, got %s", t.Name(), want, br)
+		return
+	} else if br.ID() != bindRuleID {
+		t.Errorf("%s failed: want ID %s, got %s", t.Name(), bindRuleID, br.ID())
+		return
+	} else if br.Keyword() != BindSSF || br.Operator() != Ge {
+		t.Errorf("%s failed: unexpected keyword or operator: %s %s", t.Name(), br.Keyword(), br.Operator())
+		return
+	}
+
+	// operators not permitted for the keyword are not set
+	if br = BR(BindUDN, Ge, UDN(`uid=jesse,ou=People,dc=example,dc=com`)); br.Operator() == Ge {
+		t.Errorf("%s failed: illegal operator was set: %s", t.Name(), br)
+	}
+}
+
+func ExampleBindRule_Describe() {
+	fmt.Println(UDN(`uid=jesse,ou=People,dc=example,dc=com`).Eq().Describe())
+	// Output: userdn [Equal To] "ldap:///uid=jesse,ou=People,dc=example,dc=com"
+}
+
+func TestBindRule_Describe(t *testing.T) {
+	for idx, tc := range []struct {
+		rule BindRule
+		want string
+	}{
+		{SSF(128).Ge().Paren(), `ssf [Greater Than Or Equal] "128"`},
+		{ToD(`1700`).Lt().NoPadding(true), `timeofday [Less Than] "1700"`},
+		{UDNs(`uid=a,dc=example,dc=com`, `uid=b,dc=example,dc=com`).Ne(), `userdn [Not Equal To] "ldap:///uid=a,dc=example,dc=com || ldap:///uid=b,dc=example,dc=com"`},
+		{BindRule{}, ``},
+	} {
+		if got := tc.rule.Describe(); got != tc.want {
+			t.Errorf("%s[%d] failed:\nwant: %s\ngot:  %s", t.Name(), idx, tc.want, got)
+			return
+		}
+	}
+}
+
+func ExampleBindRules_Simplify() {
+	rule := And().Paren().Push(
+		And().Paren().Push(
+			UDN(`uid=jesse,ou=People,dc=example,dc=com`).Eq().Paren(),
+		),
+	)
+
+	fmt.Println(rule)
+	fmt.Println(rule.Simplify())
+	// Output:
+	// ( ( ( userdn = "ldap:///uid=jesse,ou=People,dc=example,dc=com" ) ) )
+	// ( userdn = "ldap:///uid=jesse,ou=People,dc=example,dc=com" )
+}
+
+func TestBindRules_Simplify(t *testing.T) {
+	a := func() BindRule { return UDN(`uid=a,dc=example,dc=com`).Eq() }
+	b := func() BindRule { return SSF(128).Ge() }
+	c := func() BindRule { return SASL.Eq() }
+	d := func() BindRule { return IP(`10.0.0.0/8`).Eq() }
+
+	for idx, tc := range []struct {
+		rule BindRules
+		want string
+	}{
+		{
+			And(a().Paren(), And(b(), c()).Paren()),
+			`userdn = "ldap:///uid=a,dc=example,dc=com" AND ssf >= "128" AND authmethod = "SASL"`,
+		},
+		{
+			Or(a(), Or().Paren().Push(And(b()).Paren()), And(c(), d()).Paren()),
+			`userdn = "ldap:///uid=a,dc=example,dc=com" OR ssf >= "128" OR ( authmethod = "SASL" AND ip = "10.0.0.0/8" )`,
+		},
+		{
+			And(a(), Not().Paren().Push(And(b().Paren()).Paren())),
+			`userdn = "ldap:///uid=a,dc=example,dc=com" AND NOT ssf >= "128"`,
+		},
+		{
+			And(a(), Not(Or(b(), c()))),
+			`userdn = "ldap:///uid=a,dc=example,dc=com" AND NOT ( ssf >= "128" OR authmethod = "SASL" )`,
+		},
+		{
+			Or().Paren().Push(Or(a(), b()).Paren()),
+			`( userdn = "ldap:///uid=a,dc=example,dc=com" OR ssf >= "128" )`,
+		},
+	} {
+		orig := tc.rule.String()
+		if got := tc.rule.Simplify().String(); got != tc.want {
+			t.Errorf("%s[%d] failed:\nwant: %s\ngot:  %s", t.Name(), idx, tc.want, got)
+			return
+		} else if tc.rule.String() != orig {
+			t.Errorf("%s[%d] failed: receiver was modified", t.Name(), idx)
+			return
+		}
+	}
+
+	// leaf quotation and padding states are retained
+	multi := `userdn = "ldap:///uid=a,dc=example,dc=com" || "ldap:///uid=b,dc=example,dc=com"`
+	parsed, err := ParseBindRules(`( ( ` + multi + ` ) )`)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+	for idx, tc := range []struct {
+		rule BindRules
+		want string
+	}{
+		{parsed.(BindRules), multi},
+		{
+			And(UDNs(`uid=a,dc=example,dc=com`, `uid=b,dc=example,dc=com`).Eq().SetQuoteStyle(MultivalSliceQuotes).Paren()),
+			multi,
+		},
+		{
+			And(a().NoPadding(true).Paren(), b()),
+			`userdn="ldap:///uid=a,dc=example,dc=com" AND ssf >= "128"`,
+		},
+	} {
+		if got := tc.rule.Simplify().String(); got != tc.want {
+			t.Errorf("%s[leaf %d] failed:\nwant: %s\ngot:  %s", t.Name(), idx, tc.want, got)
+			return
+		}
+	}
+
+	var zero BindRules
+	if !zero.Simplify().IsZero() {
+		t.Errorf("%s failed: expected zero %T", t.Name(), zero)
+	}
+}
+
+func ExampleBindRules_Depth() {
+	rules := Or(
+		UDN(`uid=jesse,ou=People,dc=example,dc=com`).Eq(),
+		And(
+			SSF(128).Ge(),
+			Not(IP(`10.0.0.0/8`).Eq()),
+		),
+	)
+
+	fmt.Println(rules.Depth())
+	// Output: 3
+}
+
+func TestBindRules_Depth(t *testing.T) {
+	for idx, tc := range []struct {
+		rules BindRules
+		want  int
+	}{
+		{BindRules{}, 0},
+		{And(), 1},
+		{And(SSF(128).Ge(), SASL.Eq()), 1},
+		{And(SSF(128).Ge(), Or(SASL.Eq(), Simple.Eq())), 2},
+		{And(Or(SASL.Eq()), Not(Or(Simple.Eq(), And(SSF(1).Ge())))), 4},
+	} {
+		if got := tc.rules.Depth(); got != tc.want {
+			t.Errorf("%s[%d] failed: want %d, got %d", t.Name(), idx, tc.want, got)
+			return
+		}
+	}
+}
+
+func TestBooleanWordLowerCase(t *testing.T) {
+	defer func() { BooleanWordLowerCase = false }()
+
+	build := func() BindRules {
+		return And().Paren().Push(
+			Or().Paren().Push(UDN(`uid=a,dc=example,dc=com`).Eq(), UDN(`uid=b,dc=example,dc=com`).Eq()),
+			Not().Paren().Push(SSF(0).Eq()),
+		)
+	}
+
+	upper := `( ( userdn = "ldap:///uid=a,dc=example,dc=com" OR userdn = "ldap:///uid=b,dc=example,dc=com" ) AND NOT ( ssf = "0" ) )`
+	lower := `( ( userdn = "ldap:///uid=a,dc=example,dc=com" or userdn = "ldap:///uid=b,dc=example,dc=com" ) and not ( ssf = "0" ) )`
+
+	if got := build().String(); got != upper {
+		t.Errorf("%s failed [default]:\nwant: %s\ngot:  %s", t.Name(), upper, got)
+		return
+	}
+
+	BooleanWordLowerCase = true
+	if got := build().String(); got != lower {
+		t.Errorf("%s failed [built]:\nwant: %s\ngot:  %s", t.Name(), lower, got)
+		return
+	}
+
+	br, err := ParseBindRules(upper)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if got := br.String(); got != lower {
+		t.Errorf("%s failed [parsed]:\nwant: %s\ngot:  %s", t.Name(), lower, got)
+		return
+	}
+
+	// folding an individual stack also folds its nested NOT,
+	// but never the content of a quoted value.
+	BooleanWordLowerCase = false
+	want := `userdn = "ldap:///cn=and NOT,dc=example,dc=com" and not ( ssf = "0" )`
+	folded := And(UDN(`cn=and NOT,dc=example,dc=com`).Eq(), Not().Paren().Push(SSF(0).Eq())).Fold(true)
+	if got := folded.String(); got != want {
+		t.Errorf("%s failed [folded]:\nwant: %s\ngot:  %s", t.Name(), want, got)
+	}
+}
@@ -0,0 +1,93 @@
+package aci
+
+/*
+build.go contains the pooled buffer facilities used during string assembly.
+*/
+
+import (
+	"bytes"
+	"sync"
+)
+
+/*
+maxPooledBufferSize is the capacity beyond which a buffer shall not be returned to the pool, thereby preventing a single (unusually large) assembly from pinning memory indefinitely.
+*/
+const maxPooledBufferSize = 1 << 16
+
+/*
+bufferPool contains reusable *[bytes.Buffer] instances for use during string assembly of the [Instruction], [Instructions], [PermissionBindRule] and [PermissionBindRules] types.
+
+Note that [bytes.Buffer] is used rather than [strings.Builder], as the latter relinquishes its underlying memory upon being reset and therefore offers nothing to a pool.
+*/
+var bufferPool = sync.Pool{
+	New: func() any {
+		return new(bytes.Buffer)
+	},
+}
+
+/*
+getBuffer returns an empty *[bytes.Buffer] instance obtained from the pool.
+*/
+func getBuffer() *bytes.Buffer {
+	return bufferPool.Get().(*bytes.Buffer)
+}
+
+/*
+putBuffer resets and returns buf to the pool, unless its capacity exceeds maxPooledBufferSize.
+*/
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() <= maxPooledBufferSize {
+		buf.Reset()
+		bufferPool.Put(buf)
+	}
+}
+
+/*
+assembleList returns the string values produced by each of the n stringer calls, delimited by delim, in the same manner as a [stackage.List] bearing the same delimiter with padding disabled. Zero length values are skipped.
+
+The result is then condensed using stackCondense, as [stackage] would do.
+*/
+func assembleList(n int, delim byte, value func(int) string) string {
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	for i := 0; i < n; i++ {
+		if s := value(i); len(s) > 0 {
+			if buf.Len() > 0 {
+				buf.WriteByte(delim)
+			}
+			buf.WriteString(s)
+		}
+	}
+
+	return stackCondense(buf.Bytes())
+}
+
+/*
+stackCondense returns the string form of b, having removed leading and trailing WHSP and condensed all contiguous space and TAB characters (ASCII #32 and #9 respectively) into single space characters.
+
+This mirrors the behavior of the (private) condensing function applied by [stackage] to the output of every [stackage.Stack] string representation, with two exceptions: repeated string concatenation is avoided, and multi-byte (UTF-8) characters are copied intact. The latter function widens each individual byte into a rune, which corrupts non-ASCII text (e.g.: an ACL label of `José` becomes `JosÃ©`).
+*/
+func stackCondense(b []byte) string {
+	b = bytes.TrimSpace(b)
+
+	buf := getBuffer()
+	defer putBuffer(buf)
+	buf.Grow(len(b))
+
+	var last bool // previous char was WHSP or HTAB
+	for _, c := range b {
+		switch c {
+		case '\t', ' ':
+			if !last {
+				buf.WriteByte(' ')
+			}
+			last = true
+		default:
+			last = false
+			buf.WriteByte(c)
+		}
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,121 @@
+package aci
+
+import (
+	"testing"
+)
+
+func buildTestInstructions() Instructions {
+	acis := benchmarkInstructions(3)
+	acis.Push(
+		ACI(
+			"Label  with\tdiverse   WHSP",
+			TRs(
+				TDN(`ou=People,dc=example,dc=com`).Eq(),
+				TAs(`cn`, `sn`, `givenName`).Ne(),
+			),
+			PBRs(
+				PBR(Allow(ReadAccess, SearchAccess), And(
+					Anyone().Eq(),
+					ToD(`0730`).Ge(),
+					ToD(`1615`).Lt(),
+				)),
+				PBR(Deny(WriteAccess), Not(UDN(`uid=jesse,ou=People,dc=example,dc=com`).Eq())),
+			),
+		),
+		ACI(
+			"Café",
+			PBR(Allow(AllAccess), Or(GDN(`cn=admins,dc=example,dc=com`).Eq(), SSF(128).Ge())),
+		),
+	)
+
+	return acis
+}
+
+func TestInstructions_stringIdentity(t *testing.T) {
+	// stackage corrupts non-ASCII text, so compare
+	// only the ASCII instances with its output.
+	acis := buildTestInstructions()
+	acis.cast().Pop()
+	if got, want := acis.String(), acis.cast().String(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	for i := 0; i < acis.Len(); i++ {
+		pbrs := acis.Index(i).PBRs()
+		if got, want := pbrs.String(), pbrs.cast().String(); got != want {
+			t.Errorf("%s failed [%d]: want '%s', got '%s'", t.Name(), i, want, got)
+			return
+		}
+	}
+
+	if got, want := ACIs().String(), ACIs().cast().String(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	var z Instructions
+	if got := z.String(); got != `` {
+		t.Errorf("%s failed: want '', got '%s'", t.Name(), got)
+	}
+}
+
+func TestInstructions_stringUTF8(t *testing.T) {
+	i := ACI("José", PBR(Allow(ReadAccess), Anyone().Eq()))
+	acis := ACIs(i)
+	if got, want := acis.String(), i.String(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+	}
+}
+
+func TestStackCondense(t *testing.T) {
+	for raw, want := range map[string]string{
+		``:                 ``,
+		" \t ":             ``,
+		"\n a  \t b \n":    `a b`,
+		"a\nb":             "a\nb",
+		"x\t\ty  z":        `x y z`,
+		`(version 3.0; a)`: `(version 3.0; a)`,
+		"Café \t au  lait": `Café au lait`,
+	} {
+		if got := stackCondense([]byte(raw)); got != want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		}
+	}
+}
+
+func BenchmarkInstructions_StringStackage(b *testing.B) {
+	acis := benchmarkInstructions(100)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = acis.cast().String()
+	}
+}
+
+func BenchmarkInstruction_String(b *testing.B) {
+	i := buildTestInstructions().Index(3)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = i.String()
+	}
+}
+
+func BenchmarkPermissionBindRules_String(b *testing.B) {
+	pbrs := buildTestInstructions().Index(3).PBRs()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = pbrs.String()
+	}
+}
+
+func BenchmarkPermissionBindRules_StringStackage(b *testing.B) {
+	pbrs := buildTestInstructions().Index(3).PBRs()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = pbrs.cast().String()
+	}
+}
@@ -4,8 +4,13 @@ package aci
 cop.go contains comparison operator types and methods.
 */
 
+import (
+	"github.com/JesseCoretta/go-stackage"
+)
+
 var (
 	comparisonOperatorMap              map[string]ComparisonOperator
+	comparisonOperatorLookup           map[string]ComparisonOperator
 	permittedTargetComparisonOperators map[TargetKeyword][]ComparisonOperator
 	permittedBindComparisonOperators   map[BindKeyword][]ComparisonOperator
 )
@@ -118,6 +123,33 @@ func (r ComparisonOperator) Compare(cop any) bool {
 	return false
 }
 
+/*
+Inverse returns the logical inverse of the receiver instance. Eq and Ne are mutually inverse, as are Lt and Ge, and Gt and Le.
+
+A bogus [ComparisonOperator] is returned if the receiver is bogus, as no meaningful inverse exists.
+
+Note that the return value may not be permitted for use with a given [Keyword]. See [BindRule.Invert] for a keyword-aware alternative.
+*/
+func (r ComparisonOperator) Inverse() (inv ComparisonOperator) {
+	inv = badCop
+	switch r {
+	case Eq:
+		inv = Ne
+	case Ne:
+		inv = Eq
+	case Lt:
+		inv = Ge
+	case Ge:
+		inv = Lt
+	case Gt:
+		inv = Le
+	case Le:
+		inv = Gt
+	}
+
+	return
+}
+
 /*
 isValidCopNumeral merely returns the Boolean evaluation result of a check to see whether integer x falls within a numerical range of one (1) through six (6).
 
@@ -143,6 +175,9 @@ func keywordAllowsComparisonOperator(kw, op any) (allowed bool) {
 		cop = tv
 	case int:
 		cop = ComparisonOperator(tv)
+	case stackage.ComparisonOperator:
+		// as found within parsed rules.
+		cop = ComparisonOperator(tv)
 	default:
 		return
 	}
@@ -170,17 +205,13 @@ func keywordAllowsComparisonOperator(kw, op any) (allowed bool) {
 /*
 matchCOP reads the *string representation* of a ComparisonOperator instance and returns the appropriate ComparisonOperator constant.
 
+The string representation may be the operator symbol, the context name or the description of the desired ComparisonOperator. Case is not significant.
+
 A bogus ComparisonOperator (badCop, 0x0) shall be returned if a match was not made.
 */
 func matchCOP(op string) ComparisonOperator {
-	for _, v := range comparisonOperatorMap {
-		if strInSliceFold(op, []string{
-			v.String(),
-			v.Context(),
-			v.Description(),
-		}) {
-			return v
-		}
+	if cop, found := comparisonOperatorLookup[lc(op)]; found {
+		return cop
 	}
 
 	return badCop
@@ -242,6 +273,20 @@ func init() {
 		Ge.String(): Ge,
 	}
 
+	// populate the reverse lookup map used by matchCOP,
+	// keyed on the lowercased symbol, context name and
+	// description of each comparison operator.
+	comparisonOperatorLookup = make(map[string]ComparisonOperator, len(comparisonOperatorMap)*3)
+	for _, cop := range comparisonOperatorMap {
+		for _, key := range []string{
+			cop.String(),
+			cop.Context(),
+			cop.Description(),
+		} {
+			comparisonOperatorLookup[lc(key)] = cop
+		}
+	}
+
 	// populate the allowed comparison operator map per each
 	// possible TargetRule keyword
 	permittedTargetComparisonOperators = map[TargetKeyword][]ComparisonOperator{
@@ -272,3 +317,22 @@ func init() {
 		BindToD: {Eq, Ne, Lt, Le, Gt, Ge},
 	}
 }
+
+/*
+unparen returns the rendered rule s less its outermost parenthetical characters, if paren is true. This allows the bare form of a [BindRule] or [TargetRule] to be produced without altering the receiver's parenthetical state, which would not be safe for concurrent use.
+*/
+func unparen(s string, paren bool) string {
+	if s = trimS(s); paren && hasPfx(s, `(`) && hasSfx(s, `)`) {
+		s = trimS(s[1 : len(s)-1])
+	}
+
+	return s
+}
+
+/*
+describeRule is a private function called by the [BindRule.Describe] and [TargetRule.Describe] methods. The bare (unparenthesized) string representation of a rule is split into its keyword and expression components, between which the description of op is placed.
+*/
+func describeRule(kw Keyword, op ComparisonOperator, bare string) string {
+	expr := trimS(trimPfx(trimS(trimPfx(bare, kw.String())), op.String()))
+	return sprintf("%s [%s] %s", kw, op.Description(), expr)
+}
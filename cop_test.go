@@ -149,3 +149,70 @@ func TestComparisonOperator_codecov(t *testing.T) {
 		}
 	}
 }
+
+/*
+matchCOPLinear is the former (linear scan) implementation
+of matchCOP, retained only for benchmark comparison.
+*/
+func matchCOPLinear(op string) ComparisonOperator {
+	for _, v := range comparisonOperatorMap {
+		if strInSliceFold(op, []string{
+			v.String(),
+			v.Context(),
+			v.Description(),
+		}) {
+			return v
+		}
+	}
+
+	return badCop
+}
+
+var benchmarkCOPs []string = []string{
+	`=`, `!=`, `<`, `<=`, `>`, `>=`,
+	`Ge`, `greater than or equal`, `bogus`,
+}
+
+func TestMatchCOP_parity(t *testing.T) {
+	for _, op := range append(benchmarkCOPs, `EQ`, `Not Equal To`, ``) {
+		if got, want := matchCOP(op), matchCOPLinear(op); got != want {
+			t.Errorf("%s failed [%s]: want '%s', got '%s'",
+				t.Name(), op, want.Context(), got.Context())
+			return
+		}
+	}
+}
+
+func BenchmarkMatchCOP(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, op := range benchmarkCOPs {
+			_ = matchCOP(op)
+		}
+	}
+}
+
+func BenchmarkMatchCOP_linear(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, op := range benchmarkCOPs {
+			_ = matchCOPLinear(op)
+		}
+	}
+}
+
+func ExampleComparisonOperator_Inverse() {
+	fmt.Printf("%s %s %s", Eq.Inverse(), Lt.Inverse(), Le.Inverse())
+	// Output: != >= >
+}
+
+func TestComparisonOperator_Inverse(t *testing.T) {
+	for _, op := range []ComparisonOperator{Eq, Ne, Lt, Le, Gt, Ge} {
+		if inv := op.Inverse(); inv == badCop || inv == op || inv.Inverse() != op {
+			t.Errorf("%s failed: bad inverse for %s: %s", t.Name(), op.Context(), inv)
+			return
+		}
+	}
+
+	if inv := badCop.Inverse(); inv != badCop {
+		t.Errorf("%s failed: want %s, got %s", t.Name(), badCop, inv)
+	}
+}
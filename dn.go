@@ -46,6 +46,16 @@ var (
 	badTDN string = `<invalid_target_distinguished_name>`
 )
 
+/*
+DN alias constants define the reserved values which may be used in place of an actual distinguished name within [BindUDN] [BindRule] instances.
+*/
+const (
+	aliasAll    = `all`    // ldap:///all
+	aliasAnyone = `anyone` // ldap:///anyone
+	aliasSelf   = `self`   // ldap:///self
+	aliasParent = `parent` // ldap:///parent
+)
+
 /*
 BindDistinguishedName describes a single distinguished name. For example:
 
@@ -160,10 +170,16 @@ func validDistinguishedName(x any) (err error) {
 	case BindDistinguishedName:
 		if tv.IsZero() {
 			err = nilInstanceErr(tv)
+		} else if isInvalidDNSyntax(*tv.distinguishedName.string) {
+			err = illegalSyntaxPerTypeErr(*tv.distinguishedName.string, tv.distinguishedName.Keyword)
+		} else if tv.distinguishedName.Keyword == BindRDN {
+			err = validRoleDN(*tv.distinguishedName.string)
 		}
 	case TargetDistinguishedName:
 		if tv.IsZero() {
 			err = nilInstanceErr(tv)
+		} else if isInvalidDNSyntax(*tv.distinguishedName.string) {
+			err = illegalSyntaxPerTypeErr(*tv.distinguishedName.string, tv.distinguishedName.Keyword)
 		}
 	}
 
@@ -180,6 +196,27 @@ func (r BindDistinguishedName) Keyword() Keyword {
 	return r.distinguishedName.Keyword
 }
 
+/*
+As returns a new instance of [BindDistinguishedName] bearing the receiver's distinguished name and the [BindKeyword] kw, which must be one (1) of [BindUDN], [BindRDN] or [BindGDN]. The receiver is not modified.
+
+A bogus [BindDistinguishedName] is returned if the receiver is invalid, if kw is not one (1) of the aforementioned keywords, or if the distinguished name is not valid for use with kw. For example, DN aliases (e.g.: `ldap:///anyone`) cannot be retagged as [BindRDN].
+*/
+func (r BindDistinguishedName) As(kw BindKeyword) (b BindDistinguishedName) {
+	if err := r.Valid(); err != nil {
+		return
+	}
+
+	switch kw {
+	case BindUDN, BindRDN, BindGDN:
+		b = BindDistinguishedName{newDistinguishedName(*r.distinguishedName.string, kw)}
+		if err := b.Valid(); err != nil {
+			b = BindDistinguishedName{}
+		}
+	}
+
+	return
+}
+
 /*
 Keyword returns the [Keyword] assigned to the receiver instance. This shall be the keyword that appears in a [TargetRule] bearing the receiver as a condition value.
 */
@@ -191,10 +228,39 @@ func (r TargetDistinguishedName) Keyword() Keyword {
 }
 
 /*
-Valid wraps the [stackage.Stack.Valid] method.
+As returns a new instance of [TargetDistinguishedName] bearing the receiver's distinguished name and the [TargetKeyword] kw, which must be one (1) of [Target], [TargetTo] or [TargetFrom]. The receiver is not modified.
+
+A bogus [TargetDistinguishedName] is returned if the receiver is invalid, if kw is not one (1) of the aforementioned keywords, or if the distinguished name is not valid for use with kw.
 */
-func (r BindDistinguishedNames) Valid() error {
-	return r.cast().Valid()
+func (r TargetDistinguishedName) As(kw TargetKeyword) (t TargetDistinguishedName) {
+	if err := r.Valid(); err != nil {
+		return
+	}
+
+	switch kw {
+	case Target, TargetTo, TargetFrom:
+		t = TargetDistinguishedName{newDistinguishedName(*r.distinguishedName.string, kw)}
+		if err := t.Valid(); err != nil {
+			t = TargetDistinguishedName{}
+		}
+	}
+
+	return
+}
+
+/*
+Valid wraps the [stackage.Stack.Valid] method. Additionally, each [BindDistinguishedName] or [LDAPURI] residing within the receiver must be valid in its own right.
+*/
+func (r BindDistinguishedNames) Valid() (err error) {
+	if err = r.cast().Valid(); err != nil {
+		return
+	}
+
+	for i := 0; i < r.Len() && err == nil; i++ {
+		err = r.Index(i).Valid()
+	}
+
+	return
 }
 
 /*
@@ -416,6 +482,53 @@ func UDN(x string) BindDistinguishedName {
 	return BindDistinguishedName{newDistinguishedName(x, BindUDN)}
 }
 
+/*
+AllUsers returns an instance of [BindDistinguishedName] which bears the `all` DN alias, which describes all *known user* DNs; this does not imply ANONYMOUS DNs.
+
+The return value shall be suitable for use in creating a [BindRule] that bears the [BindUDN] [BindKeyword], e.g.: userdn = "ldap:///all".
+*/
+func AllUsers() BindDistinguishedName {
+	return UDN(aliasAll)
+}
+
+/*
+Anyone returns an instance of [BindDistinguishedName] which bears the `anyone` DN alias, which describes all user DNs, known or anonymous.
+
+The return value shall be suitable for use in creating a [BindRule] that bears the [BindUDN] [BindKeyword], e.g.: userdn = "ldap:///anyone".
+*/
+func Anyone() BindDistinguishedName {
+	return UDN(aliasAnyone)
+}
+
+/*
+Self returns an instance of [BindDistinguishedName] which bears the `self` DN alias, which describes a user's own DN.
+
+The return value shall be suitable for use in creating a [BindRule] that bears the [BindUDN] [BindKeyword], e.g.: userdn = "ldap:///self".
+*/
+func Self() BindDistinguishedName {
+	return UDN(aliasSelf)
+}
+
+/*
+Parent returns an instance of [BindDistinguishedName] which bears the `parent` DN alias, which describes a user's superior DN.
+
+The return value shall be suitable for use in creating a [BindRule] that bears the [BindUDN] [BindKeyword], e.g.: userdn = "ldap:///parent".
+*/
+func Parent() BindDistinguishedName {
+	return UDN(aliasParent)
+}
+
+/*
+IsAlias returns a Boolean value indicative of whether the receiver bears one of the reserved DN aliases, i.e.: `all`, `anyone`, `self` or `parent`. Case is not significant in the matching process.
+*/
+func (r BindDistinguishedName) IsAlias() bool {
+	if r.IsZero() {
+		return false
+	}
+
+	return isDNAlias(*r.distinguishedName.string)
+}
+
 /*
 RDN initializes, sets and returns an instance of [BindDistinguishedName]. A distinguished name in string form is required.
 
@@ -469,7 +582,7 @@ func newDistinguishedName(x string, kw Keyword) (d *distinguishedName) {
 	d.Keyword = kw
 
 	if len(x) != 0 {
-		x = chopDNPfx(x)
+		x = normalizeDNEscapes(chopDNPfx(x))
 		d.string = &x
 	}
 
@@ -570,6 +683,15 @@ func (r BindDistinguishedName) BRM() BindRuleMethods {
 	})
 }
 
+/*
+Rule returns a [BindRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [BindRule] is returned if op is not permitted for use with the receiver.
+
+See also the [BindDistinguishedName.BRM] method.
+*/
+func (r BindDistinguishedName) Rule(op any) BindRule {
+	return r.BRM().rule(op)
+}
+
 /*
 BRM returns an instance of [BindRuleMethods].
 
@@ -586,6 +708,15 @@ func (r BindDistinguishedNames) BRM() BindRuleMethods {
 	})
 }
 
+/*
+Rule returns a [BindRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [BindRule] is returned if op is not permitted for use with the receiver.
+
+See also the [BindDistinguishedNames.BRM] method.
+*/
+func (r BindDistinguishedNames) Rule(op any) BindRule {
+	return r.BRM().rule(op)
+}
+
 /*
 TRM returns an instance of [TargetRuleMethods].
 
@@ -602,6 +733,15 @@ func (r TargetDistinguishedName) TRM() TargetRuleMethods {
 	})
 }
 
+/*
+Rule returns a [TargetRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [TargetRule] is returned if op is not permitted for use with the receiver.
+
+See also the [TargetDistinguishedName.TRM] method.
+*/
+func (r TargetDistinguishedName) Rule(op any) TargetRule {
+	return r.TRM().rule(op)
+}
+
 /*
 TRM returns an instance of [TargetRuleMethods].
 
@@ -618,6 +758,15 @@ func (r TargetDistinguishedNames) TRM() TargetRuleMethods {
 	})
 }
 
+/*
+Rule returns a [TargetRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [TargetRule] is returned if op is not permitted for use with the receiver.
+
+See also the [TargetDistinguishedNames.TRM] method.
+*/
+func (r TargetDistinguishedNames) Rule(op any) TargetRule {
+	return r.TRM().rule(op)
+}
+
 /*
 ID returns the string literal `bind`.
 */
@@ -837,7 +986,7 @@ func bindDNToCondition(dest any, op ComparisonOperator) (b BindRule, ok bool) {
 	var kw Keyword
 	switch tv := dest.(type) {
 	case BindDistinguishedName:
-		if tv.IsZero() {
+		if tv.Valid() != nil {
 			return
 		}
 		value = tv
@@ -928,8 +1077,320 @@ func (r BindDistinguishedNames) setExpressionValues(key Keyword, values ...strin
 	return
 }
 
+/*
+isInvalidDNSyntax returns a Boolean value indicative of whether the input dn value does NOT appear to be a well-formed distinguished name. The local scheme (ldap:///), if present, must have been removed beforehand.
+
+The leading RDN component must bear an attribute type, followed by an equals sign (=) and a non-zero value. Subsequent components are held to the same standard if they contain an equals sign, and must never be zero length. Escaped commas (\\,) do not delimit RDN components. ACI macro components, such as ($dn) or [$dn], are permitted as-is. DN aliases (e.g.: `anyone`) are always considered valid.
+
+Any URI parameters (i.e.: text following a question mark) are not considered during this process.
+*/
 func isInvalidDNSyntax(dn string) bool {
-	return (len(dn) < 3 || !(contains(dn, `=`) || !isDNAlias(dn)))
+	if idx := idxr(dn, '?'); idx != -1 {
+		dn = dn[:idx]
+	}
+
+	if isDNAlias(dn) {
+		return false
+	} else if len(dn) < 3 {
+		return true
+	}
+
+	for i, rdn := range splitDN(dn) {
+		if !isValidRDN(rdn, i == 0) {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+isValidRDN returns a Boolean value indicative of whether the input value represents a single well-formed RDN component (e.g.: `uid=jesse`) or an ACI macro component. If leading is false, a non-zero component lacking an equals sign (=) is tolerated.
+*/
+func isValidRDN(rdn string, leading bool) bool {
+	rdn = trimS(rdn)
+	if isDNMacro(rdn) {
+		return true
+	}
+
+	idx := idxr(rdn, '=')
+	if idx == -1 {
+		return !leading && len(rdn) > 0
+	} else if idx == 0 || idx == len(rdn)-1 {
+		return false
+	}
+
+	if contains(rdn[:idx], ` `) || !validDNEscapes(rdn) {
+		return false
+	}
+
+	// Each attribute value assertion within a multi-valued
+	// RDN (e.g.: `cn=Jesse+uid=jesse`) must be well-formed.
+	for _, ava := range splitUnescaped(rdn, '+') {
+		if idx = idxr(ava, '='); idx < 1 || idx == len(ava)-1 || contains(ava[:idx], ` `) {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+isDNMacro returns a Boolean value indicative of whether the input value appears to be an ACI macro component, such as ($dn), [$dn] or ($attr.<at>).
+*/
+func isDNMacro(x string) bool {
+	return (hasPfx(x, `($`) && hasSfx(x, `)`)) ||
+		(hasPfx(x, `[$`) && hasSfx(x, `]`))
+}
+
+/*
+splitDN splits the input dn value into RDN components using the comma (ASCII #44) as the delimiter. Escaped commas (\\,) are not honored as delimiters.
+*/
+func splitDN(dn string) (rdns []string) {
+	rdns = splitUnescaped(dn, ',')
+
+	return
+}
+
+/*
+validDNEscapes returns a Boolean value indicative of whether all backslash (\) escapes within x conform to RFC 4514 Section 2.4, in that each must be followed by either a special character (e.g.: `\,`, `\+`, `\"`) or a pair of hexadecimal digits (e.g.: `\C3\A9`). Unescaped quotation marks (") are also rejected.
+
+An escaped asterisk (\*) is also tolerated, as unescaped asterisks denote wildcards within ACI DN patterns.
+*/
+func validDNEscapes(x string) bool {
+	for i := 0; i < len(x); i++ {
+		switch x[i] {
+		case '"':
+			return false
+		case '\\':
+			switch {
+			case i+2 < len(x) && isHexByte(x[i+1]) && isHexByte(x[i+2]):
+				i += 2
+			case i+1 < len(x) && contains(`"+,;<>\ #=*`, string(x[i+1])):
+				i++
+			default:
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+/*
+normalizeDNEscapes returns x with any escaped quotation mark (\") replaced by its equivalent RFC 4514 hexadecimal escape (\22). This prevents the value from prematurely terminating the quoted expression in which it shall ultimately reside, without altering its meaning.
+
+Additionally, any non-ASCII (UTF-8) byte is rendered as an RFC 4514 hexadecimal pair, e.g.: "José" becomes "Jos\C3\A9". Both forms are equivalent per the RFC, however only the latter survives string assembly within [stackage] intact. All other escapes are preserved as-is.
+*/
+func normalizeDNEscapes(x string) string {
+	var clean bool = !contains(x, `\"`)
+	for i := 0; i < len(x) && clean; i++ {
+		clean = x[i] < 0x80
+	}
+
+	if clean {
+		return x
+	}
+
+	var out string
+	for i := 0; i < len(x); i++ {
+		switch {
+		case x[i] == '\\' && i+1 < len(x):
+			if x[i+1] == '"' {
+				out += `\22`
+			} else {
+				out += x[i : i+2]
+			}
+			i++
+		case x[i] >= 0x80:
+			out += sprintf("\\%02X", x[i])
+		default:
+			out += x[i : i+1]
+		}
+	}
+
+	return out
+}
+
+/*
+isHexByte returns a Boolean value indicative of whether c is a hexadecimal digit.
+*/
+func isHexByte(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
+
+/*
+splitUnescaped splits x into components using delimiter d. Escaped instances of d are not honored as delimiters.
+*/
+func splitUnescaped(x string, d byte) (parts []string) {
+	var last int
+	for i := 0; i < len(x); i++ {
+		if x[i] == '\\' {
+			i++ // skip escaped char
+		} else if x[i] == d {
+			parts = append(parts, x[last:i])
+			last = i + 1
+		}
+	}
+	parts = append(parts, x[last:])
+
+	return
+}
+
+/*
+MinimalTarget returns the narrowest single [TargetDistinguishedName] and [SearchScope] combination that covers all of the input entry DNs, alongside an error instance. This is useful when consolidating per-entry ACIs into a single ACI. The result is determined as follows:
+
+  - If all DNs are identical, the DN is returned alongside [BaseObject]
+  - If one DN is an ancestor of all others, that DN is returned alongside [Subtree]
+  - If all DNs are immediate children of a common parent, the parent is returned alongside [SingleLevel]
+  - Otherwise, the nearest common ancestor is returned alongside [Subordinate]
+
+An error is returned if no DNs are provided, if any DN is invalid or is a DN alias, or if the DNs share no common suffix. Case is not significant in the matching process; the returned DN bears the case of the first input DN.
+*/
+func MinimalTarget(dns ...string) (tdn TargetDistinguishedName, scope SearchScope, err error) {
+	if len(dns) == 0 {
+		err = minimalTargetNoDNsErr()
+		return
+	}
+
+	rdns := make([][]string, len(dns))
+	for i := 0; i < len(dns); i++ {
+		dn := chopDNPfx(trimS(dns[i]))
+		if isDNAlias(dn) || contains(dn, `?`) || isInvalidDNSyntax(dn) {
+			err = illegalSyntaxPerTypeErr(dns[i], Target)
+			return
+		}
+
+		rdns[i] = splitDN(dn)
+		for j := 0; j < len(rdns[i]); j++ {
+			rdns[i][j] = trimS(rdns[i][j])
+		}
+	}
+
+	n := commonDNSuffixLen(rdns)
+	if n == 0 {
+		err = minimalTargetNoSuffixErr()
+		return
+	}
+
+	tdn = TDN(join(rdns[0][len(rdns[0])-n:], `,`))
+	scope = minimalScope(rdns, n)
+
+	return
+}
+
+/*
+commonDNSuffixLen returns the number of RDNs, counting from the suffix downward, that are common to all of the input RDN sequences.
+*/
+func commonDNSuffixLen(rdns [][]string) (n int) {
+	for ; ; n++ {
+		for i := 0; i < len(rdns); i++ {
+			if n >= len(rdns[i]) || !eq(rdns[i][len(rdns[i])-1-n], rdns[0][len(rdns[0])-1-n]) {
+				return
+			}
+		}
+	}
+}
+
+/*
+minimalScope returns the narrowest [SearchScope] which, when applied to a common suffix of n RDNs, covers all of the input RDN sequences. See [MinimalTarget].
+*/
+func minimalScope(rdns [][]string, n int) SearchScope {
+	lo, hi := len(rdns[0]), len(rdns[0])
+	for i := 1; i < len(rdns); i++ {
+		if l := len(rdns[i]); l < lo {
+			lo = l
+		} else if l > hi {
+			hi = l
+		}
+	}
+
+	switch {
+	case hi == n:
+		return BaseObject
+	case lo == n:
+		return Subtree
+	case hi == n+1:
+		return SingleLevel
+	}
+
+	return Subordinate
+}
+
+/*
+validRoleDN returns an error if the input value, which is presumed to be syntactically valid, is unsuitable for use as a role DN. Unlike user DNs, role DNs must refer to actual directory entries: the DN aliases (e.g.: `ldap:///anyone`) and LDAP URI search parameters are not permitted.
+*/
+func validRoleDN(x string) (err error) {
+	if isDNAlias(x) || contains(x, `?`) {
+		err = illegalSyntaxPerTypeErr(x, BindRDN)
+	}
+
+	return
+}
+
+/*
+dnPatternsOverlap returns a Boolean value indicative of whether DN patterns a and b describe overlapping portions of the DIT. The LDAP scheme prefix, if present, is ignored.
+
+RDNs are compared right-to-left (i.e.: from the suffix downward) until the shorter of the two patterns is exhausted, meaning that a DN is considered to overlap with any of its descendants. An asterisk (*) within an RDN value is treated as a wildcard, e.g.: `uid=*,ou=People` overlaps with `uid=jesse,ou=People`.
+*/
+func dnPatternsOverlap(a, b string) bool {
+	ra, rb := splitDN(chopDNPfx(a)), splitDN(chopDNPfx(b))
+	for i, j := len(ra)-1, len(rb)-1; i >= 0 && j >= 0; i, j = i-1, j-1 {
+		if !rdnPatternsOverlap(trimS(ra[i]), trimS(rb[j])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+rdnPatternsOverlap is a private function called by dnPatternsOverlap. Case is not significant in the matching process.
+*/
+func rdnPatternsOverlap(a, b string) bool {
+	if a == `*` || b == `*` {
+		return true
+	}
+
+	ia, ib := idxr(a, '='), idxr(b, '=')
+	if ia == -1 || ib == -1 {
+		return eq(a, b)
+	} else if !eq(trimS(a[:ia]), trimS(b[:ib])) {
+		return false
+	}
+
+	va, vb := lc(trimS(a[ia+1:])), lc(trimS(b[ib+1:]))
+	return globMatch(va, vb) || globMatch(vb, va)
+}
+
+/*
+globMatch returns a Boolean value indicative of whether value matches pattern, in which any asterisk (*) matches any sequence of zero (0) or more characters. No other characters bear special meaning.
+*/
+func globMatch(pattern, value string) bool {
+	var p, v int
+	star, mark := -1, 0
+
+	for v < len(value) {
+		if p < len(pattern) && pattern[p] == '*' {
+			star, mark = p, v
+			p++
+		} else if p < len(pattern) && pattern[p] == value[v] {
+			p++
+			v++
+		} else if star != -1 {
+			p = star + 1
+			mark++
+			v = mark
+		} else {
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
 }
 
 /*
@@ -1305,6 +1766,33 @@ func pushTargetDistinguishedNames(kw Keyword, x any) (tdn TargetDistinguishedNam
 	return
 }
 
+/*
+Sort reorders the receiver's [BindDistinguishedName] (and [LDAPURI]) instances in place, by lexical order of their normalized (lowercased, unprefixed) DNs, and returns the receiver. This produces a canonical multi-valued expression, useful when comparing against server output.
+*/
+func (r BindDistinguishedNames) Sort() BindDistinguishedNames {
+	sortStack(r.cast(), lessDN)
+	return r
+}
+
+/*
+Sort reorders the receiver's [TargetDistinguishedName] instances in place, by lexical order of their normalized (lowercased, unprefixed) DNs, and returns the receiver. This produces a canonical multi-valued expression, useful when comparing against server output.
+*/
+func (r TargetDistinguishedNames) Sort() TargetDistinguishedNames {
+	sortStack(r.cast(), lessDN)
+	return r
+}
+
+/*
+lessDN is the less closure used by the DN stack Sort methods.
+*/
+func lessDN(a, b any) bool {
+	A, B := sprintf("%s", a), sprintf("%s", b)
+	if na, nb := lc(trimS(chopDNPfx(A))), lc(trimS(chopDNPfx(B))); na != nb {
+		return na < nb
+	}
+	return A < B
+}
+
 /*
 Pop wraps the [stackage.Stack.Pop] method and performs type assertion to return a proper [BindDistinguishedName] instance.
 */
@@ -1340,6 +1828,21 @@ func (r BindDistinguishedNames) uDNPushPolicy(x ...any) error {
 	if r.contains(x[0]) {
 		return pushErrorNotUnique(r, x[0], r.Keyword())
 	}
+
+	// userdn values may freely mix DN aliases (e.g.:
+	// ldap:///anyone), actual DNs and LDAP URIs, but
+	// each must be valid in its own right.
+	switch tv := x[0].(type) {
+	case BindDistinguishedName:
+		if err := tv.Valid(); err != nil {
+			return pushErrorNilOrZero(r, tv, BindUDN, err)
+		}
+	case LDAPURI:
+		if err := tv.Valid(); err != nil {
+			return pushErrorNilOrZero(r, tv, BindUDN, err)
+		}
+	}
+
 	return distinguishedNamesPushPolicy(r, x[0], BindUDN)
 }
 
@@ -1360,6 +1863,18 @@ func (r BindDistinguishedNames) rDNPushPolicy(x ...any) error {
 	if r.contains(x[0]) {
 		return pushErrorNotUnique(r, x[0], r.Keyword())
 	}
+
+	// each role DN must be valid in its own right;
+	// LDAP URIs are not permitted for roledn.
+	switch tv := x[0].(type) {
+	case BindDistinguishedName:
+		if err := tv.Valid(); err != nil {
+			return pushErrorNilOrZero(r, tv, BindRDN, err)
+		}
+	case LDAPURI:
+		return pushErrorBadType(r, tv, BindRDN)
+	}
+
 	return distinguishedNamesPushPolicy(r, x[0], BindRDN)
 }
 
@@ -1699,31 +2214,36 @@ type DistinguishedNameContext interface {
 	isDistinguishedNameContext()
 }
 
+/*
+chopDNPfx removes the LDAP scheme prefix from the input value, if present. Both the local scheme (ldap:///) and a non-local scheme bearing a host and (optional) port (e.g.: ldap://host:389/) are recognized and removed, thus the return value shall only contain the DN portion found after the scheme and hostport.
+*/
 func chopDNPfx(x string) string {
-	if hasPfx(x, LocalScheme) {
+	if hasPfx(lc(x), LocalScheme) {
 		x = x[len(LocalScheme):]
+	} else if hasPfx(lc(x), `ldap://`) {
+		x = x[len(`ldap://`):]
+		if idx := idxr(x, '/'); idx != -1 {
+			x = x[idx+1:]
+		}
 	}
 	return x
 }
 
+/*
+isDNAlias returns a Boolean value indicative of whether the input value represents one of the reserved DN aliases, i.e.: `all`, `anyone`, `self` or `parent`. The LDAP scheme prefix, if present, is ignored. Case is not significant in the matching process.
+*/
 func isDNAlias(x string) bool {
-	for _, dn := range []BindDistinguishedName{
-		AllDN, AnyDN, SelfDN, ParentDN,
-	} {
-		if eq(x, dn.String()) {
-			return true
-		}
-	}
-
-	return false
+	return strInSliceFold(chopDNPfx(x), []string{
+		aliasAll, aliasAnyone, aliasSelf, aliasParent,
+	})
 }
 
 /*
 init will initialize any global vars residing in this file.
 */
 func init() {
-	AllDN = UDN(`all`)       // ldap:///all
-	AnyDN = UDN(`anyone`)    // ldap:///anyone
-	SelfDN = UDN(`self`)     // ldap:///self
-	ParentDN = UDN(`parent`) // ldap:///parent
+	AllDN = AllUsers()  // ldap:///all
+	AnyDN = Anyone()    // ldap:///anyone
+	SelfDN = Self()     // ldap:///self
+	ParentDN = Parent() // ldap:///parent
 }
@@ -137,6 +137,7 @@ func TestTargetDistinguishedName_codecov(t *testing.T) {
 			O.Set(`_`)
 			_ = O.Valid()
 			O.Set(`#barf`, kw)
+			O.Set(dn, kw)
 
 			// DNs qualify for equality and negated equality
 			// comparison operators.
@@ -205,6 +206,17 @@ func TestBindDistinguishedNames_codecov(t *testing.T) {
 			var O BindDistinguishedName
 			var Ol int = Os.Len()
 
+			// role DNs do not permit LDAP URI
+			// search parameters.
+			if kw == BindRDN && contains(dn, `?`) {
+				if Os.Push(dn); Os.Len() != Ol {
+					t.Errorf("%s [%s] multival failed: illegal %s value pushed: %s",
+						t.Name(), Id, kw, dn)
+					return
+				}
+				continue
+			}
+
 			if err := O.Valid(); err == nil {
 				t.Errorf("%s [%s] multival failed: invalid %T returned no validity error",
 					t.Name(), Id, O)
@@ -614,6 +626,99 @@ func ExampleUDNs() {
 	// Output: ldap:///uid=jesse,ou=People,dc=example,dc=com || ldap:///uid=courtney,ou=People,dc=example,dc=com
 }
 
+/*
+This example demonstrates the mixing of actual DNs with DN aliases within a single multivalued userdn bind rule.
+*/
+func ExampleUDNs_mixedAliases() {
+	udns := UDNs(
+		`uid=jesse,ou=People,dc=example,dc=com`,
+		AnyDN,
+	)
+	fmt.Printf("%s", udns.Eq())
+	// Output: userdn = "ldap:///uid=jesse,ou=People,dc=example,dc=com || ldap:///anyone"
+}
+
+func TestUDNs_mixedAliases(t *testing.T) {
+	udns := UDNs(`uid=jesse,ou=People,dc=example,dc=com`, `ldap:///self`)
+	udns.Push(AllDN, `ldap:///uid=*,ou=People,dc=example,dc=com??one?(status=active)`)
+	if err := udns.Valid(); err != nil || udns.Len() != 4 {
+		t.Errorf("%s failed: want 4 valid values, got %d (%v)", t.Name(), udns.Len(), err)
+		return
+	}
+
+	// bogus DNs must not be admitted alongside aliases
+	for _, bogus := range []any{`bogus`, `=jesse,dc=example,dc=com`, `ldap:///nobody`, UDN(`bogus`)} {
+		if udns.Push(bogus); udns.Len() != 4 {
+			t.Errorf("%s failed: bogus %T value (%v) was pushed", t.Name(), bogus, bogus)
+			return
+		}
+	}
+
+	raw := `userdn = "ldap:///uid=jesse,ou=People,dc=example,dc=com || ldap:///anyone"`
+	br, err := ParseBindRules(raw)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if got := br.String(); got != raw {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), raw, got)
+		return
+	}
+}
+
+func ExampleMinimalTarget() {
+	tdn, scope, err := MinimalTarget(
+		`uid=jesse,ou=People,dc=example,dc=com`,
+		`uid=courtney,ou=People,dc=example,dc=com`,
+	)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%s\n%s", tdn.Eq(), scope.Eq())
+	// Output:
+	// ( target = "ldap:///ou=People,dc=example,dc=com" )
+	// ( targetscope = "onelevel" )
+}
+
+func TestMinimalTarget(t *testing.T) {
+	for idx, tc := range []struct {
+		dns   []string
+		want  string
+		scope SearchScope
+	}{
+		{[]string{`uid=jesse,ou=People,dc=example,dc=com`},
+			`ldap:///uid=jesse,ou=People,dc=example,dc=com`, BaseObject},
+		{[]string{`ou=People,dc=example,dc=com`, `uid=jesse,OU=people,dc=example,dc=com`},
+			`ldap:///ou=People,dc=example,dc=com`, Subtree},
+		{[]string{`ldap:///uid=jesse,ou=People,dc=example,dc=com`, `ou=Groups,dc=example,dc=com`},
+			`ldap:///dc=example,dc=com`, Subordinate},
+		{[]string{`ou=People,dc=example,dc=com`, `ou=Groups,dc=example,dc=com`, `ou=Roles,dc=example,dc=com`},
+			`ldap:///dc=example,dc=com`, SingleLevel},
+	} {
+		tdn, scope, err := MinimalTarget(tc.dns...)
+		if err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		} else if tdn.String() != tc.want || scope != tc.scope {
+			t.Errorf("%s[%d] failed: want %s/%s, got %s/%s", t.Name(), idx, tc.want, tc.scope, tdn, scope)
+			return
+		}
+	}
+
+	for idx, bogus := range [][]string{
+		{},
+		{`dc=example,dc=com`, `dc=example,dc=net`},
+		{`uid=jesse,dc=example,dc=com`, `ldap:///anyone`},
+		{`=jesse,dc=example,dc=com`},
+	} {
+		if _, _, err := MinimalTarget(bogus...); err == nil {
+			t.Errorf("%s[%d] failed: expected error for %v, got nil", t.Name(), idx, bogus)
+			return
+		}
+	}
+}
+
 func ExampleRDNs() {
 	rdns := RDNs(
 		`cn=Default,ou=Profiles,dc=example,dc=com`,
@@ -925,3 +1030,302 @@ func ExampleTFDNs() {
 	fmt.Printf("%s contains %d DNs", tdns.Keyword(), tdns.Len())
 	// Output: target_from contains 2 DNs
 }
+
+func ExampleUDN_nonLocalScheme() {
+	// A non-local scheme bearing a hostport is
+	// stripped, and the local scheme is imposed
+	// during string representation.
+	fmt.Printf("%s", UDN(`ldap://ldap.example.com:389/uid=jesse,ou=People,dc=example,dc=com`))
+	// Output: ldap:///uid=jesse,ou=People,dc=example,dc=com
+}
+
+func ExampleTDN_localScheme() {
+	// The local scheme is never doubled.
+	fmt.Printf("%s", TDN(`ldap:///ou=People,dc=example,dc=com`))
+	// Output: ldap:///ou=People,dc=example,dc=com
+}
+
+func ExampleBindDistinguishedName_Valid_badDN() {
+	fmt.Printf("Valid: %t", UDN(`ldap://ldap.example.com/=jesse,ou=People`).Valid() == nil)
+	// Output: Valid: false
+}
+
+func TestIsInvalidDNSyntax(t *testing.T) {
+	for dn, bad := range map[string]bool{
+		`uid=jesse,ou=People,dc=example,dc=com`:       false,
+		`cn=Smith\, John,ou=People,dc=example,dc=com`: false,
+		`cn=DomainAdmins,ou=Groups,[$dn],dc=example`:  false,
+		`ou=People,dc=example,dc=com??one?(uid=*)`:    false,
+		`anyone`:               false,
+		`ldap:///self`:         false,
+		`jesse`:                true,
+		`=jesse,ou=People`:     true,
+		`uid=,ou=People`:       true,
+		`uid=jesse,,ou=People`: true,
+		`u`:                    true,
+	} {
+		if got := isInvalidDNSyntax(dn); got != bad {
+			t.Errorf("%s failed [%s]: want %t, got %t",
+				t.Name(), dn, bad, got)
+		}
+	}
+}
+
+func ExampleAnyone() {
+	fmt.Printf("%s", Anyone().Eq())
+	// Output: userdn = "ldap:///anyone"
+}
+
+func ExampleSelf() {
+	fmt.Printf("%s", Self().Eq())
+	// Output: userdn = "ldap:///self"
+}
+
+func ExampleAllUsers() {
+	fmt.Printf("%s", AllUsers().Eq())
+	// Output: userdn = "ldap:///all"
+}
+
+func ExampleParent() {
+	fmt.Printf("%s", Parent().Eq())
+	// Output: userdn = "ldap:///parent"
+}
+
+func ExampleBindDistinguishedName_IsAlias() {
+	fmt.Printf("%t, %t", UDN(`ldap:///Anyone`).IsAlias(), UDN(`uid=jesse,dc=example,dc=com`).IsAlias())
+	// Output: true, false
+}
+
+func ExampleRDN_roleDN() {
+	fmt.Println(RDN(`cn=admins,ou=roles,dc=example,dc=com`).Eq())
+	// Output: roledn = "ldap:///cn=admins,ou=roles,dc=example,dc=com"
+}
+
+func TestRDN_validation(t *testing.T) {
+	for _, bogus := range []string{
+		`bogus`,
+		`anyone`,
+		`ldap:///self`,
+		`ldap:///ou=roles,dc=example,dc=com?cn?one`,
+	} {
+		if err := RDN(bogus).Valid(); err == nil {
+			t.Errorf("%s failed: invalid role DN '%s' accepted", t.Name(), bogus)
+			return
+		} else if br := RDN(bogus).Eq(); br != badBindRule {
+			t.Errorf("%s failed: rule built from invalid role DN '%s': %s", t.Name(), bogus, br)
+			return
+		}
+	}
+
+	rdns := RDNs(
+		`cn=admins,ou=roles,dc=example,dc=com`,
+		`bogus`,
+		`ldap:///anyone`,
+		`cn=operators,ou=roles,dc=example,dc=com`,
+	)
+
+	want := `roledn = "ldap:///cn=admins,ou=roles,dc=example,dc=com || ldap:///cn=operators,ou=roles,dc=example,dc=com"`
+	if got := rdns.Eq().String(); got != want {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), want, got)
+		return
+	}
+}
+
+func ExampleTargetDistinguishedName_As() {
+	dn := TDN(`uid=*,ou=People,dc=example,dc=com`)
+	fmt.Printf("%s", dn.As(TargetTo).Eq())
+	// Output: ( target_to = "ldap:///uid=*,ou=People,dc=example,dc=com" )
+}
+
+func TestTargetDistinguishedName_As(t *testing.T) {
+	dn := TFDN(`uid=*,ou=People,dc=example,dc=com`)
+	for _, kw := range []TargetKeyword{Target, TargetTo, TargetFrom} {
+		as := dn.As(kw)
+		if got := as.Keyword(); got != kw {
+			t.Errorf("%s failed: want %s, got %v", t.Name(), kw, got)
+			return
+		} else if as.String() != dn.String() {
+			t.Errorf("%s failed: DN altered: want %s, got %s", t.Name(), dn, as)
+			return
+		}
+	}
+
+	if dn.Keyword() != TargetFrom {
+		t.Errorf("%s failed: receiver was modified", t.Name())
+		return
+	}
+
+	var zero TargetDistinguishedName
+	for idx, bogus := range []TargetDistinguishedName{
+		dn.As(TargetAttr),
+		dn.As(TargetKeyword(0x0)),
+		zero.As(Target),
+	} {
+		if !bogus.IsZero() {
+			t.Errorf("%s[%d] failed: expected bogus %T, got %s", t.Name(), idx, bogus, bogus)
+			return
+		}
+	}
+}
+
+func ExampleBindDistinguishedName_As() {
+	dn := UDN(`cn=Admins,ou=Groups,dc=example,dc=com`)
+	fmt.Printf("%s", dn.As(BindGDN).Eq())
+	// Output: groupdn = "ldap:///cn=Admins,ou=Groups,dc=example,dc=com"
+}
+
+func TestBindDistinguishedName_As(t *testing.T) {
+	dn := RDN(`cn=Auditor,ou=Roles,dc=example,dc=com`)
+	for _, kw := range []BindKeyword{BindUDN, BindRDN, BindGDN} {
+		as := dn.As(kw)
+		if got := as.Keyword(); got != kw {
+			t.Errorf("%s failed: want %s, got %v", t.Name(), kw, got)
+			return
+		} else if as.String() != dn.String() {
+			t.Errorf("%s failed: DN altered: want %s, got %s", t.Name(), dn, as)
+			return
+		}
+	}
+
+	if dn.Keyword() != BindRDN {
+		t.Errorf("%s failed: receiver was modified", t.Name())
+		return
+	}
+
+	var zero BindDistinguishedName
+	for idx, bogus := range []BindDistinguishedName{
+		dn.As(BindUAT),
+		dn.As(BindSSF),
+		zero.As(BindUDN),
+		Anyone().As(BindRDN),
+		Self().As(BindRDN),
+	} {
+		if !bogus.IsZero() {
+			t.Errorf("%s[%d] failed: expected bogus %T, got %s", t.Name(), idx, bogus, bogus)
+			return
+		}
+	}
+}
+
+func ExampleUDN_escapedValue() {
+	dn := UDN(`cn=Jos\C3\A9,ou=People,dc=example,dc=com`)
+	fmt.Printf("%t: %s", dn.Valid() == nil, dn)
+	// Output: true: ldap:///cn=Jos\C3\A9,ou=People,dc=example,dc=com
+}
+
+func TestDistinguishedName_escapes(t *testing.T) {
+	for idx, pair := range [][2]string{
+		{`cn=Jos\C3\A9,ou=People,dc=example,dc=com`, `ldap:///cn=Jos\C3\A9,ou=People,dc=example,dc=com`},
+		{`cn=Smith\, John,ou=People,dc=example,dc=com`, `ldap:///cn=Smith\, John,ou=People,dc=example,dc=com`},
+		{`cn=a\+b+uid=ab,ou=People,dc=example,dc=com`, `ldap:///cn=a\+b+uid=ab,ou=People,dc=example,dc=com`},
+		{`cn=\"Quoted\",ou=People,dc=example,dc=com`, `ldap:///cn=\22Quoted\22,ou=People,dc=example,dc=com`},
+		{`cn=José,ou=People,dc=example,dc=com`, `ldap:///cn=Jos\C3\A9,ou=People,dc=example,dc=com`},
+	} {
+		dn := UDN(pair[0])
+		if err := dn.Valid(); err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		} else if got := dn.String(); got != pair[1] {
+			t.Errorf("%s[%d] failed: want %s, got %s", t.Name(), idx, pair[1], got)
+			return
+		}
+
+		// Make sure the value survives a trip through
+		// a multi-valued stack and the parser.
+		raw := TDNs().Push(TDN(pair[0])).Eq().String()
+		tr, err := ParseTargetRules(raw)
+		if err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		} else if got := tr.String(); got != raw {
+			t.Errorf("%s[%d] failed: want %s, got %s", t.Name(), idx, raw, got)
+			return
+		}
+	}
+
+	// Raw UTF-8 submitted to the parser is normalized to its
+	// hexadecimal form, same as with direct construction.
+	// Text that merely resembles mis-encoded UTF-8, such as
+	// a literal `Ã©`, must be preserved byte for byte.
+	for idx, tc := range []struct {
+		raw, want string
+	}{
+		{`( target = "ldap:///cn=José,dc=example,dc=com" )`, `( target = "ldap:///cn=Jos\C3\A9,dc=example,dc=com" )`},
+		{`( target = "ldap:///cn=JosÃ©,dc=example,dc=com" )`, `( target = "ldap:///cn=Jos\C3\83\C2\A9,dc=example,dc=com" )`},
+	} {
+		if tr, err := ParseTargetRules(tc.raw); err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		} else if got := tr.String(); got != tc.want {
+			t.Errorf("%s[%d] failed: want %s, got %s", t.Name(), idx, tc.want, got)
+			return
+		}
+	}
+
+	for idx, bogus := range []string{
+		`cn=Jos\Zz,dc=example,dc=com`,
+		`cn=Jos\C,dc=example,dc=com`,
+		`cn=Jos\,dc=example,dc=com\`,
+		`cn="Jos",dc=example,dc=com`,
+		`cn=a+=b,dc=example,dc=com`,
+	} {
+		if err := UDN(bogus).Valid(); err == nil {
+			t.Errorf("%s[%d] failed: expected error for %s, got nil", t.Name(), idx, bogus)
+			return
+		}
+	}
+}
+
+func ExampleTargetDistinguishedNames_Sort() {
+	tdns := TDNs(`uid=*,ou=People,dc=example,dc=com`, `cn=*,ou=Groups,dc=example,dc=com`)
+	fmt.Printf("%s", tdns.Sort().Eq())
+	// Output: ( target = "ldap:///cn=*,ou=Groups,dc=example,dc=com || ldap:///uid=*,ou=People,dc=example,dc=com" )
+}
+
+func TestDistinguishedNames_Sort(t *testing.T) {
+	udns := UDNs(
+		`uid=jesse,ou=People,dc=example,dc=com`,
+		`UID=Admin,ou=People,dc=example,dc=com`,
+		`cn=Manager,dc=example,dc=com`,
+	)
+
+	want := `userdn = "ldap:///cn=Manager,dc=example,dc=com || ldap:///UID=Admin,ou=People,dc=example,dc=com || ldap:///uid=jesse,ou=People,dc=example,dc=com"`
+	if got := udns.Sort().Eq().String(); got != want {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), want, got)
+		return
+	}
+
+	tdns := TDNs(`ou=People,dc=example,dc=com`, `ou=Groups,dc=example,dc=com`)
+	if got := tdns.Sort().Index(0).String(); got != `ldap:///ou=Groups,dc=example,dc=com` {
+		t.Errorf("%s failed: receiver not sorted in place; got %s", t.Name(), got)
+	}
+}
+
+func TestDistinguishedNames_mixedPrefix(t *testing.T) {
+	want := `userdn = "ldap:///uid=a,dc=example,dc=com || ldap:///uid=b,dc=example,dc=com || ldap:///anyone || ldap:///uid=c,dc=example,dc=com"`
+
+	udns := UDNs().Push(
+		UDN(`uid=a,dc=example,dc=com`),
+		UDN(`ldap:///uid=b,dc=example,dc=com`),
+		Anyone(),
+		`LDAP:///uid=c,dc=example,dc=com`,
+	)
+	if got := udns.Eq().String(); got != want {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), want, got)
+		return
+	}
+
+	br, err := ParseBindRules(`userdn = "uid=a,dc=example,dc=com || ldap:///uid=b,dc=example,dc=com || anyone || ldap:///uid=c,dc=example,dc=com"`)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if got := br.String(); got != want {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), want, got)
+		return
+	}
+
+	tdns := TDNs(`ou=People,dc=example,dc=com`, TDN(`ldap:///ou=Groups,dc=example,dc=com`))
+	if got := tdns.Eq().String(); got != `( target = "ldap:///ou=People,dc=example,dc=com || ldap:///ou=Groups,dc=example,dc=com" )` {
+		t.Errorf("%s failed: inconsistent prefix: %s", t.Name(), got)
+	}
+}
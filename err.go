@@ -23,8 +23,70 @@ func errorf(msg any, x ...any) error {
 	return nil
 }
 
+/*
+Sentinel error instances which may be used by the caller in conjunction with [errors.Is] to determine the general nature of an error returned by this package.
+
+The errors returned by this package retain their descriptive messages, while also wrapping one (1) of the following sentinels when applicable.
+*/
+var (
+	ErrNilInstance   = errors.New("nil or zero instance")
+	ErrBadKeyword    = errors.New("unknown or unresolvable keyword")
+	ErrPushNotUnique = errors.New("non-unique push candidate")
+	ErrBadType       = errors.New("unsupported or illegal type")
+)
+
+/*
+Error is the structured error type returned by certain error-producing operations within this package. The message is identical to that of a string-formatted error, while the underlying sentinel (e.g.: [ErrNilInstance]) and -- if present -- the causal error are accessible through use of [errors.Is] or [errors.As].
+*/
+type Error struct {
+	// Kind is the sentinel error that describes
+	// the general nature of the error.
+	Kind error
+
+	// Cause is the (optional) underlying error
+	// which led to the error.
+	Cause error
+
+	msg string
+}
+
+/*
+Error returns the string representation of the receiver instance.
+*/
+func (r *Error) Error() string {
+	return r.msg
+}
+
+/*
+Unwrap returns the sentinel error, as well as the causal error if set, for use by [errors.Is] and [errors.As].
+*/
+func (r *Error) Unwrap() (errs []error) {
+	errs = append(errs, r.Kind)
+	if r.Cause != nil {
+		errs = append(errs, r.Cause)
+	}
+
+	return
+}
+
+/*
+kindErr returns an instance of *[Error] bearing the message of input error err, wrapping the sentinel kind and the optional cause. A nil err results in a nil return.
+*/
+func kindErr(kind, err error, cause ...error) error {
+	if err == nil {
+		return nil
+	}
+
+	e := &Error{Kind: kind, msg: err.Error()}
+	if len(cause) > 0 {
+		e.Cause = cause[0]
+	}
+
+	return e
+}
+
 func nilInstanceErr(x any) error {
-	return errorf("%T instance is nil", x)
+	return kindErr(ErrNilInstance, errorf("%T instance is nil", x))
 }
 
 /*
@@ -54,6 +116,10 @@ func badSecurityStrengthFactorErr(raw, fac string) (err error) {
 	return
 }
 
+func badSecurityStrengthFactorRangeErr(x any) error {
+	return errorf("Invalid security strength factor '%v'; valid range is 0-256", x)
+}
+
 func unexpectedBindConditionValueErr(key BindKeyword, want, got int) (err error) {
 	if want != got {
 		err = errorf("Unexpected number of %s values; want %d, got %d", key, want, got)
@@ -99,7 +165,7 @@ func badPTBRuleKeywordErr(candidate any, typ string, want, got any) error {
 	}
 
 	emsg := "Unknown or unresolvable %s rule keyword or category for %T: want '%s', got '%s'"
-	return errorf(emsg, typ, candidate, kw, kg)
+	return kindErr(ErrBadKeyword, errorf(emsg, typ, candidate, kw, kg))
 }
 
 func noTBRuleExpressionValues(candidate any, typ string, key Keyword) error {
@@ -111,13 +177,45 @@ func badAttributeBindTypeOrValueErr(x string) error {
 	return errorf("Invalid AttributeBindTyoeOrValue instance: must conform to '<at>#<bt_or_av>', got '%s'", x)
 }
 
+func atbtvURLErr(x LDAPURI, kw BindKeyword) error {
+	return errorf("Invalid AttributeBindTypeOrValue instance: LDAP URL '%s' not permitted for %s; must be a %s search URL", x, kw, BindGAT)
+}
+
+func unknownOIDErr(kw Keyword, oid string) error {
+	return errorf("Unknown %s OID '%s'; not found in registry", kw, oid)
+}
+
+func unknownAttributeTypesErr(names []string) error {
+	return errorf("Unknown %T name(s) per schema: %s", AttributeType{}, join(names, `, `))
+}
+
+func attributeValueDelimErr(x AttributeValue) error {
+	return errorf("Invalid AttributeValue instance: unescaped '#' delimiter found in '%s'", x)
+}
+
+func badSearchFilterErr(x SearchFilter) error {
+	return errorf("Invalid SearchFilter instance: malformed RFC 4515 filter '%s'", x)
+}
+
 func badObjectIdentifierErr(x string) error {
 	return errorf("Invalid ObjectIdentifier instance: must conform to 'N[.N]+', got '%s'", x)
 }
 
 func badObjectIdentifierKeywordErr(key TargetKeyword) error {
 	emsg := "Invalid %s and/or %T[%s] value(s)"
-	return errorf(emsg, `ObjectIdentifier`, key, key)
+	return kindErr(ErrBadKeyword, errorf(emsg, `ObjectIdentifier`, key, key))
+}
+
+func headerVersionErr(v float32) error {
+	return errorf("Unsupported %T version %.1f; must be %.1f", Header{}, v, Version)
+}
+
+func headerACLErr() error {
+	return errorf("%T ACL label must be non-zero", Header{})
+}
+
+func tokenizeErr(pos int, msg string) error {
+	return errorf("Tokenize failed at offset %d: %s", pos, msg)
 }
 
 func unexpectedKindErr(receiver any, want, got string) error {
@@ -125,8 +223,8 @@ func unexpectedKindErr(receiver any, want, got string) error {
 }
 
 func illegalSliceTypeErr(receiver, want, got any) error {
-	return errorf("Illegal slice type within %T stack: should be '%T', got '%T'",
-		receiver, want, got)
+	return kindErr(ErrBadType, errorf("Illegal slice type within %T stack: should be '%T', got '%T'",
+		receiver, want, got))
 }
 
 func illegalSyntaxPerTypeErr(candidate any, key Keyword, er ...error) error {
@@ -153,6 +251,10 @@ func afosNonIdempSplitErr(d, l, o int, c rune) error {
 	return errorf(emsg, d, c, l, o)
 }
 
+func afosMixedDelimErr() error {
+	return errorf("%T contains mixed comma and semicolon delimiters", AttributeFilterOperations{})
+}
+
 func afoMissingPrefixErr() error {
 	emsg := "%T instance is missing required %T prefix: needs either add= or delete="
 	return errorf(emsg, AttributeFilterOperation{}, AttributeOperation(0))
@@ -168,16 +270,100 @@ func uriBadPrefixErr() error {
 	return errorf(emsg, LDAPURI{}, LocalScheme)
 }
 
+func uriBadHostErr(x string) error {
+	emsg := "Invalid %T hostport '%s'"
+	return errorf(emsg, LDAPURI{}, x)
+}
+
+func uriBadAttributeErr(x string) error {
+	emsg := "Invalid %T attributeType '%s'"
+	return errorf(emsg, LDAPURI{}, x)
+}
+
+func uriBadScopeErr(x string) error {
+	emsg := "Invalid %T scope '%s'; must be base, one or sub"
+	return errorf(emsg, LDAPURI{}, x)
+}
+
+func uriBadFilterErr(x string) error {
+	emsg := "Invalid %T search filter '%s'"
+	return errorf(emsg, LDAPURI{}, x)
+}
+
+func uriComponentCountErr(n int) error {
+	emsg := "Too many %T components; want at most %d, got %d"
+	return errorf(emsg, LDAPURI{}, 4, n)
+}
+
 func afMissingDelimiterErr(af AttributeFilter) error {
 	emsg := "No attr:filter delimiter (%c) found in %T"
 	return errorf(emsg, ':', af)
 }
 
+func instructionOrderErr(idx int) error {
+	return errorf("%T target rules slice %d is not a valid %T", Instruction{}, idx, TargetRule{})
+}
+
 func instructionNoLabelErr() error {
 	emsg := "%T has no name (ACL); set a string name value using %T.Set"
 	return errorf(emsg, Instruction{}, Instruction{})
 }
 
+func instructionNoPBRsErr() error {
+	emsg := "%T has no %T instances"
+	return errorf(emsg, Instruction{}, PermissionBindRule{})
+}
+
+func targetAddErr(idx int, err error) error {
+	emsg := "%T builder #%d rejected: %v"
+	return errorf(emsg, TargetRule{}, idx, err)
+}
+
+func pbrStringErr(idx int, err error) error {
+	emsg := "%T fragment #%d rejected: %v"
+	return errorf(emsg, PermissionBindRule{}, idx, err)
+}
+
+func instructionIndexErr(idx int, err error) error {
+	emsg := "%T #%d is invalid: %v"
+	return errorf(emsg, Instruction{}, idx, err)
+}
+
+func duplicateACLNamesErr(names []string) error {
+	emsg := "%T contains duplicate ACL names: %s"
+	return errorf(emsg, Instructions{}, join(names, `, `))
+}
+
+func unexpandedTokensErr(tokens []string) error {
+	emsg := "%T contains unexpanded template tokens: %s"
+	return errorf(emsg, Instruction{}, join(tokens, `, `))
+}
+
+func minimalTargetNoDNsErr() error {
+	emsg := "No DNs provided; cannot compute minimal %T"
+	return errorf(emsg, TargetDistinguishedName{})
+}
+
+func minimalTargetNoSuffixErr() error {
+	emsg := "DNs share no common suffix; cannot compute minimal %T"
+	return errorf(emsg, TargetDistinguishedName{})
+}
+
+func evalUnsupportedErr(x any, kw any) error {
+	emsg := "Evaluation of %T (%v) within %v context is not supported"
+	return errorf(emsg, x, x, kw)
+}
+
+func evalMissingContextErr(field string, kw Keyword) error {
+	emsg := "%T field %s is required for evaluation of %s context"
+	return errorf(emsg, EvalContext{}, field, kw)
+}
+
+func evalOperatorErr(op ComparisonOperator, kw Keyword) error {
+	emsg := "Evaluation of %s operator within %v context is not supported"
+	return errorf(emsg, op.Context(), kw)
+}
+
 func levelsNotFoundErr() error {
 	emsg := "No level identifiers parsed; aborting"
 	return errorf(emsg)
@@ -198,6 +384,11 @@ func dowBadDayErr(x any) error {
 	return errorf(emsg, DayOfWeek{}, x)
 }
 
+func dowDuplicateDayErr(x any) error {
+	emsg := "%T instance describes duplicate dayofweek: %v"
+	return errorf(emsg, DayOfWeek{}, x)
+}
+
 func noPermissionDispErr() error {
 	emsg := "%T has no disposition (allow/deny), or is ambiguous (nil)"
 	return errorf(emsg, Permission{})
@@ -215,7 +406,7 @@ func fqdnInvalidLenErr(l int) error {
 
 func parseBindRuleInvalidExprTypeErr(receiver, want, got any) error {
 	emsg := "Unexpected %T within %T; wanted %T"
-	return errorf(emsg, got, receiver, want)
+	return kindErr(ErrBadType, errorf(emsg, got, receiver, want))
 }
 
 func parseBindRulesHierErr(stk any, b BindContext) error {
@@ -249,6 +440,13 @@ func noValueErr(candidate any, typ string) error {
 }
 
 func pushError(receiver, candidate any, key Keyword, emsg string, er ...error) error {
+	return pushKindError(nil, receiver, candidate, key, emsg, er...)
+}
+
+/*
+pushKindError is identical to pushError, except that the return is wrapped with the sentinel kind, if non-nil.
+*/
+func pushKindError(kind error, receiver, candidate any, key Keyword, emsg string, er ...error) error {
 	var err error
 	var kw string = `<unspecified_keyword>`
 	if len(er) > 0 {
@@ -258,23 +456,76 @@ func pushError(receiver, candidate any, key Keyword, emsg string, er ...error) e
 		kw = key.String()
 	}
 
+	var e error
 	if err != nil {
-		return errorf(emsg, candidate, receiver, kw, err)
+		e = errorf(emsg, candidate, receiver, kw, err)
+	} else {
+		e = errorf(emsg, candidate, receiver, kw)
 	}
-	return errorf(emsg, candidate, receiver, kw)
+
+	if kind != nil {
+		e = kindErr(kind, e, err)
+	}
+
+	return e
 }
 
 func pushErrorNotUnique(receiver, candidate any, key Keyword, er ...error) error {
 	emsg := "Cannot push non-unique or ineligible %T into %T [%s]"
-	return pushError(receiver, candidate, key, emsg, er...)
+	return pushKindError(ErrPushNotUnique, receiver, candidate, key, emsg, er...)
 }
 
 func pushErrorNilOrZero(receiver, candidate any, key Keyword, er ...error) error {
 	var emsg string = "Cannot push zero-length or nil %T into %T [%s]: %v"
-	return pushError(receiver, candidate, key, emsg, er...)
+	return pushKindError(ErrNilInstance, receiver, candidate, key, emsg, er...)
 }
 
 func pushErrorBadType(receiver, candidate any, key Keyword, er ...error) error {
 	var emsg string = "Push request of %T type violates %T [%s] PushPolicy"
-	return pushError(receiver, candidate, key, emsg, er...)
+	return pushKindError(ErrBadType, receiver, candidate, key, emsg, er...)
+}
+
+func strictScopeErr(scope SearchScope, dn, nature string) error {
+	return errorf("%s %s scope for target DN pattern '%s'", nature, scope, dn)
+}
+
+func strictTimeOfDayErr(br BindRule) error {
+	return errorf("dubious %s equality '%s'; consider a range (e.g.: %s and %s)", BindToD, br, Ge, Le)
+}
+
+func strictSSFErr(br BindRule) error {
+	return errorf("impossible %s threshold '%s'; valid range is 0-256", BindSSF, br)
+}
+
+func strictSiblingsErr(nature string, kw Keyword, stack BindRules) error {
+	return errorf("%s %s conditions within %s stack '%s'", nature, kw, uc(stack.Category()), stack)
+}
+
+func strictAttrWildcardErr(ats AttributeTypes) error {
+	return errorf("product-specific %s wildcard combination '%s'", TargetAttr, ats)
+}
+
+func strictDepthErr(perm Permission, depth int) error {
+	return errorf("excessive bind rule depth %d for %s; maximum is %d", depth, perm, MaxBindRuleDepth)
+}
+
+func policyKeywordErr(scope string, kw Keyword) error {
+	return errorf("%s keyword '%s' not permitted by policy", scope, kw)
+}
+
+func policyRightErr(right Right) error {
+	return errorf("right '%s' not permitted by policy", right)
+}
+
+func strictRightsErr(perm Permission, nature string, right Right) error {
+	name := right.String()
+	if len(name) == 0 {
+		var names []string
+		for _, r := range rightsSlice(right) {
+			names = append(names, r.String())
+		}
+		name = join(names, `,`)
+	}
+
+	return errorf("%s use of '%s' in %s", nature, name, perm)
 }
@@ -1,6 +1,8 @@
 package aci
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 )
 
@@ -34,6 +36,9 @@ func TestErrorFNs_codecov(t *testing.T) {
 	_ = afoMissingPrefixErr()
 	_ = aoBadPrefixErr()
 	_ = instructionNoLabelErr()
+	_ = instructionNoPBRsErr()
+	_ = pbrStringErr(1, errorf("This is an error"))
+	_ = instructionIndexErr(1, errorf("This is an error"))
 	_ = dowBadTimeErr()
 	_ = badCopErr(badCop)
 	_ = rightNotfound(`barf`)
@@ -48,3 +53,43 @@ func TestErrorFNs_codecov(t *testing.T) {
 		Target, "You're in trouble",
 		errorf("Yet another error"))
 }
+
+func ExampleError() {
+	var oid ObjectIdentifier
+	err := oid.Valid()
+
+	var e *Error
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, ErrNilInstance), errors.As(err, &e))
+	// Output: aci.ObjectIdentifier instance is nil
+	// true true
+}
+
+func TestError_sentinels(t *testing.T) {
+	cause := errorf("This is an error")
+	for idx, pair := range [][]error{
+		{nilInstanceErr(Instruction{}), ErrNilInstance},
+		{badPTBRuleKeywordErr(BindRule{}, `bind`, `bindkeyword`, `bogus`), ErrBadKeyword},
+		{badObjectIdentifierKeywordErr(TargetCtrl), ErrBadKeyword},
+		{pushErrorNotUnique(PBRs(), PermissionBindRule{}, nil), ErrPushNotUnique},
+		{pushErrorNilOrZero(PBRs(), PermissionBindRule{}, nil, cause), ErrNilInstance},
+		{pushErrorBadType(PBRs(), `bogus`, nil), ErrBadType},
+		{illegalSliceTypeErr(AttributeTypes{}, AttributeType{}, `bogus`), ErrBadType},
+		{parseBindRuleInvalidExprTypeErr(BindRule{}, BindRules{}, `bogus`), ErrBadType},
+	} {
+		if err, want := pair[0], pair[1]; !errors.Is(err, want) {
+			t.Errorf("%s[%d] failed: %v does not wrap %v", t.Name(), idx, err, want)
+			return
+		}
+	}
+
+	if err := pushErrorNilOrZero(PBRs(), PermissionBindRule{}, nil, cause); !errors.Is(err, cause) {
+		t.Errorf("%s failed: %v does not wrap %v", t.Name(), err, cause)
+		return
+	}
+
+	if kindErr(ErrBadType, nil) != nil {
+		t.Errorf("%s failed: non-nil return for nil error", t.Name())
+		return
+	}
+}
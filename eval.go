@@ -0,0 +1,504 @@
+package aci
+
+/*
+eval.go contains lightweight bind rule evaluation types and methods.
+*/
+
+import (
+	"net"
+	"time"
+)
+
+/*
+EvalContext describes a hypothetical principal (and the circumstances of its connection) for use in [BindRule] and [BindRules] evaluation. The fields are as follows:
+
+  - BindDN contains the DN of the principal; a zero value denotes an anonymous principal
+  - Groups contains the DNs of all groups of which the principal is a member
+  - AuthMethod contains the [AuthenticationMethod] used by the principal
+  - SSF contains the effective security strength factor of the connection
+  - IP contains the source address of the connection
+  - Time contains the time at which access is requested
+  - TargetDN contains the DN of the entry being accessed, which is required only for the evaluation of the `self` and `parent` DN aliases
+  - TargetAttributes contains the attribute values of the entry being accessed, indexed by attribute type, which is required only for the evaluation of [BindGAT] (groupattr) rules
+  - Resolver contains an optional [GroupResolver], which is consulted for group membership not already described by Groups
+
+See the [BindRules.Evaluate] method.
+*/
+type EvalContext struct {
+	BindDN           string
+	Groups           []string
+	AuthMethod       AuthenticationMethod
+	SSF              int
+	IP               net.IP
+	Time             time.Time
+	TargetDN         string
+	TargetAttributes map[string][]string
+	Resolver         GroupResolver
+}
+
+/*
+GroupResolver is a user-implemented interface type used to expand the membership of a static or dynamic group during [BindRules] evaluation. The Members method shall return the DNs of all members of the group identified by groupDN, alongside an error if the membership could not be obtained.
+
+This package performs no LDAP I/O of its own. Implementations may consult a directory server, a cache or any other suitable source.
+
+See the Resolver field of [EvalContext].
+*/
+type GroupResolver interface {
+	Members(groupDN string) ([]string, error)
+}
+
+/*
+Evaluate returns a Boolean value indicative of whether the receiver is satisfied by the principal described by ctx, alongside an error instance. The Boolean AND, OR and NOT stacks are walked recursively, and each [BindRule] is evaluated according to the semantics of its [BindKeyword].
+
+The following [BindKeyword] contexts are supported:
+
+  - [BindUDN] (userdn), including the DN aliases and wildcard (*) RDN values
+  - [BindGDN] (groupdn), as matched against the Groups field of ctx, or as expanded by its Resolver
+  - [BindGAT] (groupattr), in which the named attribute of the target entry bears GROUPDN or USERDN values
+  - [BindIP] (ip), including CIDR and IPv4 wildcard notation
+  - [BindAM] (authmethod)
+  - [BindSSF] (ssf)
+  - [BindToD] (timeofday)
+  - [BindDoW] (dayofweek)
+
+An error is returned -- rather than a potentially incorrect answer -- if an unsupported [BindKeyword] or expression value is encountered, such as an [LDAPURI] or a DN macro, or if ctx lacks information needed to complete the evaluation.
+*/
+func (r BindRules) Evaluate(ctx EvalContext) (bool, error) {
+	return evalBindContext(r, ctx)
+}
+
+/*
+Evaluate returns a Boolean value indicative of whether the receiver is satisfied by the principal described by ctx, alongside an error instance. See [BindRules.Evaluate] for details.
+*/
+func (r BindRule) Evaluate(ctx EvalContext) (bool, error) {
+	return evalBindContext(r, ctx)
+}
+
+/*
+evalBindContext is the recursive backend of the [BindRule.Evaluate] and [BindRules.Evaluate] methods.
+*/
+func evalBindContext(b BindContext, ctx EvalContext) (ok bool, err error) {
+	if b == nil || b.IsZero() {
+		err = nilInstanceErr(b)
+		return
+	}
+
+	switch tv := b.(type) {
+	case BindRule:
+		ok, err = evalBindRule(tv, ctx)
+	case BindRules:
+		ok, err = evalBindRules(tv, ctx)
+	}
+
+	return
+}
+
+/*
+evalBindRules evaluates each member of r according to its Boolean category. The members of a NOT stack are each negated, thus the stack is satisfied only if none of its members are satisfied.
+*/
+func evalBindRules(r BindRules, ctx EvalContext) (ok bool, err error) {
+	cat := lc(r.Category())
+	if cat != `and` && cat != `or` && cat != `not` {
+		err = evalUnsupportedErr(r, cat)
+		return
+	}
+
+	ok = cat != `or`
+	for i := 0; i < r.Len(); i++ {
+		var member bool
+		if member, err = evalBindContext(r.Index(i), ctx); err != nil {
+			return false, err
+		}
+
+		switch {
+		case cat == `and` && !member, cat == `not` && member:
+			return false, nil
+		case cat == `or` && member:
+			return true, nil
+		}
+	}
+
+	return
+}
+
+/*
+evalBindRule evaluates a single [BindRule] according to the semantics of its [BindKeyword].
+*/
+func evalBindRule(r BindRule, ctx EvalContext) (ok bool, err error) {
+	op := r.Operator()
+	switch tv := r.Expression().(type) {
+	case BindDistinguishedName, BindDistinguishedNames, LDAPURI:
+		ok, err = evalBindDNs(r.Keyword(), tv, ctx)
+	case IPAddr:
+		ok, err = evalIPAddr(tv, ctx)
+	case AuthenticationMethod:
+		ok = tv == ctx.AuthMethod
+	case DayOfWeek:
+		ok, err = evalDayOfWeek(tv, ctx)
+	case AttributeBindTypeOrValue:
+		ok, err = evalGroupAttr(tv, ctx)
+	case SecurityStrengthFactor:
+		return evalOrdered(op, ctx.SSF, tv.String())
+	case TimeOfDay:
+		return evalTimeOfDay(op, tv, ctx)
+	default:
+		err = evalUnsupportedErr(r, r.Keyword())
+	}
+
+	if err == nil && op == Ne {
+		ok = !ok
+	} else if err == nil && op != Eq {
+		ok, err = false, evalOperatorErr(op, r.Keyword())
+	}
+
+	return
+}
+
+/*
+evalTimeOfDay applies comparison operator op to the time of day described by ctx and the [TimeOfDay] value tod.
+*/
+func evalTimeOfDay(op ComparisonOperator, tod TimeOfDay, ctx EvalContext) (bool, error) {
+	if ctx.Time.IsZero() {
+		return false, evalMissingContextErr(`Time`, BindToD)
+	}
+
+	return evalOrdered(op, ctx.Time.Hour()*100+ctx.Time.Minute(), tod.String())
+}
+
+/*
+evalOrdered applies comparison operator op to the integer value and the integer form of the expression string expr.
+*/
+func evalOrdered(op ComparisonOperator, value int, expr string) (ok bool, err error) {
+	var n int
+	if n, err = atoi(expr); err != nil {
+		return
+	}
+
+	switch op {
+	case Eq:
+		ok = value == n
+	case Ne:
+		ok = value != n
+	case Lt:
+		ok = value < n
+	case Le:
+		ok = value <= n
+	case Gt:
+		ok = value > n
+	case Ge:
+		ok = value >= n
+	default:
+		err = evalOperatorErr(op, nil)
+	}
+
+	return
+}
+
+/*
+evalBindDNs returns a Boolean value indicative of whether any of the DN values within dns, which may be a [BindDistinguishedName], [BindDistinguishedNames] or [LDAPURI] instance, match the principal described by ctx.
+*/
+func evalBindDNs(kw Keyword, dns any, ctx EvalContext) (ok bool, err error) {
+	var values []DistinguishedNameContext
+	switch tv := dns.(type) {
+	case BindDistinguishedNames:
+		for i := 0; i < tv.Len(); i++ {
+			values = append(values, tv.Index(i))
+		}
+	case DistinguishedNameContext:
+		values = append(values, tv)
+	}
+
+	for i := 0; i < len(values) && !ok && err == nil; i++ {
+		if _, isURI := values[i].(LDAPURI); isURI {
+			err = evalUnsupportedErr(values[i], kw)
+		} else if kw == BindGDN {
+			ok, err = evalGroupDN(chopDNPfx(values[i].String()), ctx)
+		} else if kw == BindUDN {
+			ok, err = evalUserDN(chopDNPfx(values[i].String()), ctx)
+		} else {
+			err = evalUnsupportedErr(values[i], kw)
+		}
+	}
+
+	return
+}
+
+/*
+evalUserDN returns a Boolean value indicative of whether the userdn pattern matches the principal described by ctx.
+*/
+func evalUserDN(pattern string, ctx EvalContext) (ok bool, err error) {
+	switch lc(pattern) {
+	case aliasAnyone:
+		return true, nil
+	case aliasAll:
+		return len(ctx.BindDN) > 0, nil
+	case aliasSelf, aliasParent:
+		if len(ctx.TargetDN) == 0 {
+			return false, evalMissingContextErr(`TargetDN`, BindUDN)
+		}
+		target := ctx.TargetDN
+		if eq(pattern, aliasParent) {
+			rdns := splitDN(target)
+			target = join(rdns[1:], `,`)
+		}
+		return len(ctx.BindDN) > 0 && dnPatternMatch(target, ctx.BindDN), nil
+	}
+
+	if err = evalDNPattern(pattern, BindUDN); err == nil {
+		ok = len(ctx.BindDN) > 0 && dnPatternMatch(pattern, ctx.BindDN)
+	}
+
+	return
+}
+
+/*
+evalGroupDN returns a Boolean value indicative of whether the groupdn pattern matches any of the groups of the principal described by ctx. If no such match is found, and if pattern bears no wildcards, the Resolver of ctx (if set) is consulted.
+*/
+func evalGroupDN(pattern string, ctx EvalContext) (ok bool, err error) {
+	if err = evalDNPattern(pattern, BindGDN); err != nil {
+		return
+	}
+
+	for i := 0; i < len(ctx.Groups) && !ok; i++ {
+		ok = dnPatternMatch(pattern, ctx.Groups[i])
+	}
+
+	if !ok && !contains(pattern, `*`) {
+		ok, err = resolveMembership(pattern, ctx)
+	}
+
+	return
+}
+
+/*
+resolveMembership returns a Boolean value indicative of whether the principal described by ctx is a member of groupDN, per the Resolver of ctx. A value of false is returned if the principal is anonymous, or if no Resolver was provided.
+*/
+func resolveMembership(groupDN string, ctx EvalContext) (ok bool, err error) {
+	if ctx.Resolver == nil || len(ctx.BindDN) == 0 {
+		return
+	}
+
+	var members []string
+	if members, err = ctx.Resolver.Members(groupDN); err != nil {
+		return
+	}
+
+	for i := 0; i < len(members) && !ok; i++ {
+		ok = dnPatternMatch(members[i], ctx.BindDN)
+	}
+
+	return
+}
+
+/*
+evalGroupAttr returns a Boolean value indicative of whether the principal described by ctx is referenced -- either directly (USERDN) or by way of group membership (GROUPDN) -- by the values of the attribute named within abtv, as found within the TargetAttributes of ctx. Only [BindGAT] (groupattr) rules are supported.
+*/
+func evalGroupAttr(abtv AttributeBindTypeOrValue, ctx EvalContext) (ok bool, err error) {
+	if abtv.IsZero() {
+		return false, nilInstanceErr(abtv)
+	}
+
+	bt, _ := abtv.atbtv[1].(BindType)
+	if abtv.BindKeyword != BindGAT || (bt != GROUPDN && bt != USERDN) {
+		return false, evalUnsupportedErr(abtv, abtv.Keyword())
+	} else if ctx.TargetAttributes == nil {
+		return false, evalMissingContextErr(`TargetAttributes`, BindGAT)
+	}
+
+	values := targetAttributeValues(abtv.atbtv[0], ctx.TargetAttributes)
+	for i := 0; i < len(values) && !ok && err == nil; i++ {
+		dn := chopDNPfx(values[i])
+		if bt == USERDN {
+			ok = len(ctx.BindDN) > 0 && dnPatternMatch(dn, ctx.BindDN)
+		} else {
+			ok, err = evalGroupDN(dn, ctx)
+		}
+	}
+
+	return
+}
+
+/*
+targetAttributeValues returns the values of attribute type at found within attrs. Case is not significant in the matching of attribute type names.
+*/
+func targetAttributeValues(at any, attrs map[string][]string) []string {
+	name := sprintf("%s", at)
+	for k, v := range attrs {
+		if eq(k, name) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+/*
+evalDNPattern returns an error if the DN pattern bears LDAP URI parameters or macro components, neither of which can be evaluated.
+*/
+func evalDNPattern(pattern string, kw Keyword) (err error) {
+	if contains(pattern, `?`) || contains(pattern, `($`) || contains(pattern, `[$`) {
+		err = evalUnsupportedErr(pattern, kw)
+	}
+
+	return
+}
+
+/*
+dnPatternMatch returns a Boolean value indicative of whether dn matches pattern. Both values must bear the same number of RDNs, and each RDN value within pattern may contain wildcards (*). The LDAP scheme prefix, if present, is ignored. Case is not significant in the matching process.
+*/
+func dnPatternMatch(pattern, dn string) bool {
+	rp, rd := splitDN(chopDNPfx(pattern)), splitDN(chopDNPfx(dn))
+	if len(rp) != len(rd) {
+		return false
+	}
+
+	for i := 0; i < len(rp); i++ {
+		p, d := trimS(rp[i]), trimS(rd[i])
+		ip, id := idxr(p, '='), idxr(d, '=')
+		if ip == -1 || id == -1 {
+			if !eq(p, d) {
+				return false
+			}
+		} else if !eq(trimS(p[:ip]), trimS(d[:id])) ||
+			!globMatch(lc(trimS(p[ip+1:])), lc(trimS(d[id+1:]))) {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+evalIPAddr returns a Boolean value indicative of whether the source address described by ctx falls within any of the address values of addrs.
+*/
+func evalIPAddr(addrs IPAddr, ctx EvalContext) (ok bool, err error) {
+	if ctx.IP == nil {
+		return false, evalMissingContextErr(`IP`, BindIP)
+	}
+
+	nets := addrs.Networks()
+	if len(nets) != addrs.Len() {
+		return false, evalUnsupportedErr(addrs, BindIP)
+	}
+
+	for i := 0; i < len(nets) && !ok; i++ {
+		ok = nets[i].Contains(ctx.IP)
+	}
+
+	return
+}
+
+/*
+evalDayOfWeek returns a Boolean value indicative of whether the day of the week described by ctx is positive within days.
+*/
+func evalDayOfWeek(days DayOfWeek, ctx EvalContext) (ok bool, err error) {
+	if ctx.Time.IsZero() {
+		return false, evalMissingContextErr(`Time`, BindDoW)
+	}
+
+	ok = days.Positive(weekdays[ctx.Time.Weekday()])
+	return
+}
+
+/*
+EffectiveRights returns the aggregate granting (allow) and withholding (deny) [Permission] instances that apply to the principal described by ctx when accessing the entry identified by targetDN, as derived from all [Instruction] instances within the receiver.
+
+An [Instruction] is considered to apply to targetDN if it bears no [Target] [TargetRule], or if targetDN falls within the scope (see [TargetScope], which defaults to [Subtree]) of any Equal-To [Target] DN pattern, and does not fall within the scope of any Not-Equal-To [Target] DN pattern. Wildcards (*) within a pattern are matched per RDN. All other [TargetKeyword] contexts, such as [TargetAttr] and [TargetFilter], are not considered, as they concern the attributes and content of the entry rather than its DN. As this package has no knowledge of the entry upon which a given [Instruction] resides, this is left to the caller's selection of the receiver's contents.
+
+Each [PermissionBindRule] of an applicable [Instruction] contributes its rights when its [BindRules] evaluate as true per [BindRules.Evaluate]. The TargetDN field of ctx is set to targetDN if unspecified.
+
+As is the case with most directory products, deny overrides allow: any [Right] withheld by a satisfied deny rule is absent from the allow return value, regardless of any allow rule that grants it. Furthermore, a bind rule that cannot be evaluated (i.e.: one that returns an error) is treated as unsatisfied for allow rules, but as satisfied for deny rules, such that uncertainty never results in greater access.
+*/
+func (r Instructions) EffectiveRights(ctx EvalContext, targetDN string) (allow, deny Permission) {
+	if len(ctx.TargetDN) == 0 {
+		ctx.TargetDN = targetDN
+	}
+
+	var granted, withheld Right
+	for i := 0; i < r.Len(); i++ {
+		ins := r.Index(i)
+		if ins.Valid() != nil || !ins.targetsDN(targetDN) {
+			continue
+		}
+
+		pbrs := ins.PBRs()
+		for j := 0; j < pbrs.Len(); j++ {
+			pbr := pbrs.Index(j)
+			perm := pbr.Permission()
+			if perm.Valid() != nil {
+				continue
+			}
+
+			ok, err := evalBindContext(pbr.BindRules(), ctx)
+			if pbr.Disposition() {
+				if ok && err == nil {
+					granted |= perm.bits()
+				}
+			} else if ok || err != nil {
+				withheld |= perm.bits()
+			}
+		}
+	}
+
+	allow = Allow(granted &^ withheld)
+	deny = Deny(withheld)
+
+	return
+}
+
+/*
+targetsDN returns a Boolean value indicative of whether the receiver's [Target] DN patterns, as qualified by its [TargetScope], apply to dn. See [Instructions.EffectiveRights] for details.
+*/
+func (r Instruction) targetsDN(dn string) bool {
+	var eqs, nes []string
+	scope := Subtree
+
+	trs := r.TRs()
+	for i := 0; i < trs.Len(); i++ {
+		tr := trs.Index(i)
+		switch tr.Keyword() {
+		case Target:
+			if tr.Operator() == Ne {
+				nes = append(nes, targetRuleDNs(tr)...)
+			} else {
+				eqs = append(eqs, targetRuleDNs(tr)...)
+			}
+		case TargetScope:
+			if ss, ok := tr.Expression().(SearchScope); ok && ss != noScope {
+				scope = ss
+			}
+		}
+	}
+
+	for i := 0; i < len(nes); i++ {
+		if scopedDNMatch(nes[i], dn, scope) {
+			return false
+		}
+	}
+
+	for i := 0; i < len(eqs); i++ {
+		if scopedDNMatch(eqs[i], dn, scope) {
+			return true
+		}
+	}
+
+	return len(eqs) == 0
+}
+
+/*
+scopedDNMatch returns a Boolean value indicative of whether dn matches pattern, or resides beneath it, at a depth permitted by scope.
+*/
+func scopedDNMatch(pattern, dn string, scope SearchScope) bool {
+	rp, rd := splitDN(chopDNPfx(pattern)), splitDN(chopDNPfx(dn))
+	depth := len(rd) - len(rp)
+
+	switch {
+	case depth < 0,
+		scope == BaseObject && depth != 0,
+		scope == SingleLevel && depth != 1,
+		scope == Subordinate && depth < 1:
+		return false
+	}
+
+	return dnPatternMatch(pattern, join(rd[depth:], `,`))
+}
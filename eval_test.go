@@ -0,0 +1,284 @@
+package aci
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+)
+
+func ExampleBindRules_Evaluate() {
+	rule := And().Paren().Push(
+		GDN(`cn=Admins,ou=Groups,dc=example,dc=com`).Eq(),
+		SSF(128).Ge(),
+		ToDBetween(`0800`, `1700`),
+	)
+
+	ok, err := rule.Evaluate(EvalContext{
+		BindDN: `uid=jesse,ou=People,dc=example,dc=com`,
+		Groups: []string{`cn=Admins,ou=Groups,dc=example,dc=com`},
+		SSF:    256,
+		Time:   time.Date(2024, 1, 8, 9, 30, 0, 0, time.UTC),
+	})
+	fmt.Println(ok, err)
+	// Output: true <nil>
+}
+
+func TestBindRules_Evaluate(t *testing.T) {
+	ctx := EvalContext{
+		BindDN:     `uid=jesse,ou=People,dc=example,dc=com`,
+		Groups:     []string{`cn=Staff,ou=Groups,dc=example,dc=com`},
+		AuthMethod: SASL,
+		SSF:        56,
+		IP:         net.ParseIP(`192.168.1.20`),
+		Time:       time.Date(2024, 1, 6, 23, 15, 0, 0, time.UTC), // a Saturday
+		TargetDN:   `uid=jesse,ou=People,dc=example,dc=com`,
+	}
+
+	for idx, tc := range []struct {
+		rule BindContext
+		want bool
+	}{
+		{UDN(`uid=*,ou=People,dc=example,dc=com`).Eq(), true},
+		{UDN(`uid=*,ou=Staff,dc=example,dc=com`).Eq(), false},
+		{UDN(`uid=*,ou=Staff,dc=example,dc=com`).Ne(), true},
+		{UDNs(`uid=courtney,ou=People,dc=example,dc=com`, AllDN).Eq(), true},
+		{SelfDN.Eq(), true},
+		{ParentDN.Eq(), false},
+		{GDN(`cn=staff,ou=groups,dc=example,dc=com`).Eq(), true},
+		{GDN(`cn=Admins,ou=Groups,dc=example,dc=com`).Eq(), false},
+		{IP(`192.168.*`).Eq(), true},
+		{IP(`10.0.0.0/8`).Eq(), false},
+		{IP(`10.0.0.0/8`).Ne(), true},
+		{SASL.Eq(), true},
+		{Simple.Eq(), false},
+		{SSF(56).Ge(), true},
+		{SSF(56).Gt(), false},
+		{SSF(128).Lt(), true},
+		{ToD(`2300`).Ge(), true},
+		{ToD(`2300`).Lt(), false},
+		{Weekend(Eq), true},
+		{Weekdays(Eq), false},
+		{Or(Simple.Eq(), SSF(256).Eq()), false},
+		{Or(Simple.Eq(), SASL.Eq()), true},
+		{And(SASL.Eq(), Not(Simple.Eq())), true},
+		{And(SASL.Eq(), Not(SASL.Eq())), false},
+	} {
+		got, err := evalBindContext(tc.rule, ctx)
+		if err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		} else if got != tc.want {
+			t.Errorf("%s[%d] failed: %s: want %t, got %t", t.Name(), idx, tc.rule, tc.want, got)
+			return
+		}
+	}
+
+	// anonymous principals only satisfy the `anyone` alias
+	anon := EvalContext{}
+	if ok, _ := AnyDN.Eq().Evaluate(anon); !ok {
+		t.Errorf("%s failed: anonymous principal did not satisfy %s", t.Name(), AnyDN)
+		return
+	} else if ok, _ = AllDN.Eq().Evaluate(anon); ok {
+		t.Errorf("%s failed: anonymous principal satisfied %s", t.Name(), AllDN)
+		return
+	}
+}
+
+func TestBindRules_Evaluate_errors(t *testing.T) {
+	var uri LDAPURI
+	_ = uri.Parse(`ldap:///ou=People,dc=example,dc=com??sub?(objectClass=*)`)
+
+	for idx, tc := range []struct {
+		rule BindContext
+		ctx  EvalContext
+	}{
+		{DNS(`*.example.com`).Eq(), EvalContext{}},
+		{UAT(AT(`manager`), AV(`LDAPURL`)).Eq(), EvalContext{}},
+		{UDN(`uid=($dn),dc=example,dc=com`).Eq(), EvalContext{BindDN: `uid=x,dc=example,dc=com`}},
+		{BR(BindUDN, Eq, uri), EvalContext{}},
+		{SelfDN.Eq(), EvalContext{BindDN: `uid=x,dc=example,dc=com`}},
+		{IP(`10.0.0.1`).Eq(), EvalContext{}},
+		{ToD(`0800`).Ge(), EvalContext{}},
+		{And(SASL.Eq(), DNS(`*.example.com`).Eq()), EvalContext{AuthMethod: SASL}},
+		{BindRules{}, EvalContext{}},
+	} {
+		if _, err := evalBindContext(tc.rule, tc.ctx); err == nil {
+			t.Errorf("%s[%d] failed: expected error for %s, got nil", t.Name(), idx, tc.rule)
+			return
+		}
+	}
+}
+
+/*
+staticGroups is a trivial GroupResolver implementation for testing.
+*/
+type staticGroups map[string][]string
+
+func (r staticGroups) Members(groupDN string) ([]string, error) {
+	members, found := r[lc(groupDN)]
+	if !found {
+		return nil, fmt.Errorf("no such group: %s", groupDN)
+	}
+	return members, nil
+}
+
+func ExampleGroupResolver() {
+	resolver := staticGroups{
+		`cn=auditors,ou=groups,dc=example,dc=com`: {
+			`uid=courtney,ou=People,dc=example,dc=com`,
+			`uid=jesse,ou=People,dc=example,dc=com`,
+		},
+	}
+
+	rule := GDN(`cn=Auditors,ou=Groups,dc=example,dc=com`).Eq()
+	ok, err := rule.Evaluate(EvalContext{
+		BindDN:   `uid=jesse,ou=People,dc=example,dc=com`,
+		Resolver: resolver,
+	})
+	fmt.Println(ok, err)
+	// Output: true <nil>
+}
+
+func TestEvalContext_Resolver(t *testing.T) {
+	resolver := staticGroups{
+		`cn=auditors,ou=groups,dc=example,dc=com`: {`uid=jesse,ou=People,dc=example,dc=com`},
+		`cn=owners,ou=groups,dc=example,dc=com`:   {`uid=courtney,ou=People,dc=example,dc=com`},
+	}
+	ctx := EvalContext{
+		BindDN:   `uid=jesse,ou=People,dc=example,dc=com`,
+		Resolver: resolver,
+		TargetAttributes: map[string][]string{
+			`owner`:   {`ldap:///cn=Owners,ou=Groups,dc=example,dc=com`, `cn=Auditors,ou=Groups,dc=example,dc=com`},
+			`manager`: {`uid=jesse,ou=People,dc=example,dc=com`},
+		},
+	}
+
+	for idx, tc := range []struct {
+		rule BindContext
+		want bool
+	}{
+		{GDN(`cn=Auditors,ou=Groups,dc=example,dc=com`).Eq(), true},
+		{GDN(`cn=Owners,ou=Groups,dc=example,dc=com`).Eq(), false},
+		{GDN(`cn=*,ou=Groups,dc=example,dc=com`).Eq(), false},
+		{GAT(`owner`, GROUPDN).Eq(), true},
+		{GAT(`OWNER`, GROUPDN).Ne(), false},
+		{GAT(`manager`, USERDN).Eq(), true},
+		{GAT(`secretary`, USERDN).Eq(), false},
+	} {
+		got, err := evalBindContext(tc.rule, ctx)
+		if err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		} else if got != tc.want {
+			t.Errorf("%s[%d] failed: %s: want %t, got %t", t.Name(), idx, tc.rule, tc.want, got)
+			return
+		}
+	}
+
+	// resolver errors are propagated
+	if _, err := GDN(`cn=Nobody,ou=Groups,dc=example,dc=com`).Eq().Evaluate(ctx); err == nil {
+		t.Errorf("%s failed: expected resolver error, got nil", t.Name())
+		return
+	}
+
+	// groupattr requires target attributes, and supports DN bind types only
+	ctx.TargetAttributes = nil
+	for idx, rule := range []BindRule{
+		GAT(`owner`, GROUPDN).Eq(),
+		GAT(`owner`, AV(`value`)).Eq(),
+	} {
+		if _, err := rule.Evaluate(ctx); err == nil {
+			t.Errorf("%s[%d] failed: expected error for %s, got nil", t.Name(), idx, rule)
+			return
+		}
+	}
+}
+
+func ExampleInstructions_EffectiveRights() {
+	acis := ACIs(
+		ACI(`Read people`,
+			TRs(TDN(`ou=People,dc=example,dc=com`).Eq()),
+			PBR(Allow(ReadAccess, SearchAccess, WriteAccess), AllUsers().Eq()),
+		),
+		ACI(`No writes for contractors`,
+			PBR(Deny(WriteAccess), GDN(`cn=Contractors,ou=Groups,dc=example,dc=com`).Eq()),
+		),
+	)
+
+	ctx := EvalContext{
+		BindDN: `uid=jesse,ou=People,dc=example,dc=com`,
+		Groups: []string{`cn=Contractors,ou=Groups,dc=example,dc=com`},
+	}
+
+	allow, deny := acis.EffectiveRights(ctx, `uid=courtney,ou=People,dc=example,dc=com`)
+	fmt.Printf("%s\n%s", allow, deny)
+	// Output:
+	// allow(read,search)
+	// deny(write)
+}
+
+func TestInstructions_EffectiveRights(t *testing.T) {
+	const base = `ou=People,dc=example,dc=com`
+	const child = `uid=courtney,` + base
+	const grandchild = `cn=device,` + child
+
+	pbr := PBR(Allow(ReadAccess), Anyone().Eq())
+	ctx := EvalContext{BindDN: `uid=jesse,` + base}
+
+	for idx, obj := range []struct {
+		trs    TargetRules
+		dn     string
+		expect bool
+	}{
+		{TRs(), `dc=elsewhere`, true},
+		{TRs(TDN(base).Eq()), base, true},
+		{TRs(TDN(base).Eq()), grandchild, true},
+		{TRs(TDN(base).Eq()), `ou=Groups,dc=example,dc=com`, false},
+		{TRs(TDN(base).Eq(), BaseObject.Eq()), base, true},
+		{TRs(TDN(base).Eq(), BaseObject.Eq()), child, false},
+		{TRs(TDN(base).Eq(), SingleLevel.Eq()), child, true},
+		{TRs(TDN(base).Eq(), SingleLevel.Eq()), grandchild, false},
+		{TRs(TDN(base).Eq(), Subordinate.Eq()), base, false},
+		{TRs(TDN(base).Eq(), Subordinate.Eq()), grandchild, true},
+		{TRs(TDN(`uid=*,` + base).Eq()), child, true},
+		{TRs(TDN(`uid=*,` + base).Eq()), base, false},
+		{TRs(TDN(child).Ne()), child, false},
+		{TRs(TDN(child).Ne()), base, true},
+	} {
+		acis := ACIs(ACI(`Target test`, obj.trs, pbr))
+		allow, _ := acis.EffectiveRights(ctx, obj.dn)
+		if got := allow.Positive(ReadAccess); got != obj.expect {
+			t.Errorf("%s[%d] failed: %s vs %s: want %t, got %t",
+				t.Name(), idx, obj.trs, obj.dn, obj.expect, got)
+			return
+		}
+	}
+
+	// deny overrides allow, and unevaluable deny rules are
+	// treated as satisfied, whereas unevaluable allow rules
+	// are not.
+	acis := ACIs(
+		ACI(`Allow all`, PBR(Allow(AllAccess), AllUsers().Eq())),
+		ACI(`Deny delete`, PBR(Deny(DeleteAccess), UDN(`ldap:///`+base+`??sub?(uid=x)`).Eq())),
+		ACI(`Allow proxy`, PBR(Allow(ProxyAccess), UDN(`ldap:///`+base+`??sub?(uid=y)`).Eq())),
+		ACI(`Deny write`, PBR(Deny(WriteAccess), UDN(`uid=someone,`+base).Eq())),
+	)
+
+	allow, deny := acis.EffectiveRights(ctx, child)
+	if allow.Positive(DeleteAccess) || !deny.Positive(DeleteAccess) {
+		t.Errorf("%s failed: unevaluable deny not honored: %s / %s", t.Name(), allow, deny)
+		return
+	} else if allow.Positive(ProxyAccess) {
+		t.Errorf("%s failed: unevaluable allow was honored: %s", t.Name(), allow)
+		return
+	} else if !allow.Positive(WriteAccess) || deny.Positive(WriteAccess) {
+		t.Errorf("%s failed: unsatisfied deny was honored: %s / %s", t.Name(), allow, deny)
+		return
+	}
+
+	var empty Instructions
+	if allow, deny = empty.EffectiveRights(ctx, child); allow.String() != `allow(none)` || deny.String() != `deny(none)` {
+		t.Errorf("%s failed: unexpected rights from empty %T: %s / %s", t.Name(), empty, allow, deny)
+	}
+}
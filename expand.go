@@ -0,0 +1,194 @@
+package aci
+
+/*
+expand.go contains template expansion methods for use in bulk ACI composition.
+*/
+
+import (
+	"strings"
+)
+
+/*
+Expand returns a new instance of [Instruction] in which all `{{key}}` template tokens found within the receiver's ACL label, as well as within any distinguished name expression of its [TargetRule] and [BindRule] instances, have been replaced with the corresponding values found within vars. For example, given a vars key of `ou`, the token `{{ou}}` shall be replaced by the associated value.
+
+Tokens for which no key is present within vars are left intact; the [Instruction.ValidStrict] method shall report any such tokens as an error. See also [Instruction.Tokens].
+
+The receiver is not modified. [TargetRule] and [BindRule] instances which bear no distinguished name expression are shared with the return instance as-is.
+*/
+func (r Instruction) Expand(vars map[string]string) (x Instruction) {
+	if r.IsZero() {
+		return
+	}
+
+	pairs := make([]string, 0, len(vars)*2)
+	for k, v := range vars {
+		pairs = append(pairs, `{{`+k+`}}`, v)
+	}
+	rep := strings.NewReplacer(pairs...)
+
+	x = ACI(rep.Replace(r.instruction.ACL))
+	for i := 0; i < r.instruction.TRs.Len(); i++ {
+		x.instruction.TRs.Push(expandTargetRule(r.instruction.TRs.Index(i), rep))
+	}
+
+	for i := 0; i < r.instruction.PBRs.Len(); i++ {
+		pbr := r.instruction.PBRs.Index(i)
+		x.instruction.PBRs.Push(PBR(pbr.Permission(), expandBindContext(pbr.BindRules(), rep)))
+	}
+
+	return
+}
+
+/*
+Tokens returns slices of all unique `{{key}}` template tokens found within the ACL label of the receiver, as well as within any distinguished name expression of its [TargetRule] and [BindRule] instances. A zero length return indicates that no template tokens remain. See also [Instruction.Expand].
+*/
+func (r Instruction) Tokens() (tokens []string) {
+	if r.IsZero() {
+		return
+	}
+
+	tokens = templateTokens(tokens, r.instruction.ACL)
+	for i := 0; i < r.instruction.TRs.Len(); i++ {
+		switch expr := r.instruction.TRs.Index(i).Expression().(type) {
+		case TargetDistinguishedName, TargetDistinguishedNames:
+			tokens = templateTokens(tokens, sprintf("%s", expr))
+		}
+	}
+
+	for i := 0; i < r.instruction.PBRs.Len(); i++ {
+		tokens = bindTokens(tokens, r.instruction.PBRs.Index(i).BindRules())
+	}
+
+	return
+}
+
+/*
+bindTokens appends to tokens any unique template tokens found within the distinguished name expressions of b, recursing through any nested [BindRules] instances.
+*/
+func bindTokens(tokens []string, b BindContext) []string {
+	switch tv := b.(type) {
+	case BindRule:
+		switch expr := tv.Expression().(type) {
+		case BindDistinguishedName, BindDistinguishedNames, LDAPURI:
+			tokens = templateTokens(tokens, sprintf("%s", expr))
+		}
+	case BindRules:
+		for i := 0; i < tv.Len(); i++ {
+			tokens = bindTokens(tokens, tv.Index(i))
+		}
+	}
+
+	return tokens
+}
+
+/*
+templateTokens appends to tokens any unique `{{key}}` template tokens found within x.
+*/
+func templateTokens(tokens []string, x string) []string {
+	for {
+		start := idxs(x, `{{`)
+		if start == -1 {
+			break
+		}
+
+		end := idxs(x[start:], `}}`)
+		if end == -1 {
+			break
+		}
+
+		if token := x[start : start+end+2]; !strInSlice(token, tokens) {
+			tokens = append(tokens, token)
+		}
+		x = x[start+end+2:]
+	}
+
+	return tokens
+}
+
+/*
+expandTargetRule returns a new [TargetRule] bearing the expanded form of the receiver's distinguished name expression. Other [TargetRule] instances are returned as-is.
+*/
+func expandTargetRule(tr TargetRule, rep *strings.Replacer) TargetRule {
+	kw := tr.Keyword()
+	switch tv := tr.Expression().(type) {
+	case TargetDistinguishedName:
+		return TR(kw, tr.Operator(), TargetDistinguishedName{
+			newDistinguishedName(rep.Replace(tv.String()), kw)})
+	case TargetDistinguishedNames:
+		tdns := TDNs()
+		tdns.resetKeyword(kw)
+		for i := 0; i < tv.Len(); i++ {
+			tdns.Push(rep.Replace(tv.Index(i).String()))
+		}
+		return TR(kw, tr.Operator(), tdns)
+	}
+
+	return tr
+}
+
+/*
+expandBindContext returns the expanded form of b, recursing through any nested [BindRules] instances.
+*/
+func expandBindContext(b BindContext, rep *strings.Replacer) BindContext {
+	switch tv := b.(type) {
+	case BindRule:
+		return expandBindRule(tv, rep)
+	case BindRules:
+		var x BindRules
+		switch lc(tv.Category()) {
+		case `and`:
+			x = And()
+		case `or`:
+			x = Or()
+		case `not`:
+			x = Not()
+		default:
+			return tv
+		}
+
+		for i := 0; i < tv.Len(); i++ {
+			x.Push(expandBindContext(tv.Index(i), rep))
+		}
+		return x.Paren(tv.IsParen())
+	}
+
+	return b
+}
+
+/*
+expandBindRule returns a new [BindRule] bearing the expanded form of the receiver's distinguished name or [LDAPURI] expression. Other [BindRule] instances are returned as-is.
+*/
+func expandBindRule(br BindRule, rep *strings.Replacer) BindRule {
+	kw := br.Keyword()
+	var expr any
+	switch tv := br.Expression().(type) {
+	case BindDistinguishedName, LDAPURI:
+		expr = expandBindDN(tv.(DistinguishedNameContext), kw, rep)
+	case BindDistinguishedNames:
+		bdns := UDNs()
+		bdns.resetKeyword(kw)
+		for i := 0; i < tv.Len(); i++ {
+			bdns.Push(expandBindDN(tv.Index(i), kw, rep))
+		}
+		expr = bdns
+	default:
+		return br
+	}
+
+	return BR(kw, br.Operator(), expr).Paren(br.IsParen())
+}
+
+/*
+expandBindDN returns the expanded form of the input [BindDistinguishedName] or [LDAPURI] instance. An [LDAPURI] that cannot be parsed following expansion is returned as-is.
+*/
+func expandBindDN(dn DistinguishedNameContext, kw Keyword, rep *strings.Replacer) DistinguishedNameContext {
+	raw := rep.Replace(dn.String())
+	if uri, ok := dn.(LDAPURI); ok {
+		if x, err := parseLDAPURI(raw, kw.(BindKeyword)); err == nil {
+			return x
+		}
+		return uri
+	}
+
+	return BindDistinguishedName{newDistinguishedName(raw, kw)}
+}
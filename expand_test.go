@@ -0,0 +1,80 @@
+package aci
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleInstruction_Expand() {
+	tmpl := ACI(`{{ou}} administrators`,
+		TRs().Push(TDN(`ou={{ou}},dc=example,dc=com`).Eq()),
+		PBR(Allow(AllAccess), GDN(`cn={{ou}} admins,ou=Groups,dc=example,dc=com`).Eq()),
+	)
+
+	fmt.Println(tmpl.Expand(map[string]string{`ou`: `People`}))
+	// Output: ( target = "ldap:///ou=People,dc=example,dc=com" )(version 3.0; acl "People administrators"; allow(all) groupdn = "ldap:///cn=People admins,ou=Groups,dc=example,dc=com";)
+}
+
+func ExampleInstruction_Tokens() {
+	tmpl := ACI(`{{ou}} administrators`,
+		PBR(Allow(AllAccess), GDN(`cn={{group}},ou={{ou}},dc=example,dc=com`).Eq()),
+	)
+
+	fmt.Println(tmpl.Tokens())
+	// Output: [{{ou}} {{group}}]
+}
+
+func TestInstruction_Expand(t *testing.T) {
+	tmpl := ACI(`{{ou}} access`,
+		TRs().Push(
+			TDNs(`ou={{ou}},dc=example,dc=com`, `ou=Staff,dc=example,dc=com`).Eq(),
+			TAs(`cn`, `sn`).Eq(),
+		),
+		PBR(Allow(ReadAccess), And().Paren().Push(
+			UDNs(`uid=*,ou={{ou}},dc=example,dc=com`, AnyDN).Eq(),
+			Not().Paren().Push(RDN(`cn={{role}},ou=Roles,dc=example,dc=com`).Eq()),
+			SSF(128).Ge(),
+		)),
+	)
+	orig := tmpl.String()
+
+	// tokens are tolerated by the base validity check,
+	// as a value may legitimately bear such a sequence.
+	if err := tmpl.Valid(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if err = tmpl.ValidStrict(); err == nil {
+		t.Errorf("%s failed: expected unexpanded token error, got nil", t.Name())
+		return
+	}
+
+	partial := tmpl.Expand(map[string]string{`ou`: `People`})
+	if tokens := partial.Tokens(); len(tokens) != 1 || tokens[0] != `{{role}}` {
+		t.Errorf("%s failed: unexpected tokens remain: %v", t.Name(), tokens)
+		return
+	} else if partial.ValidStrict() == nil {
+		t.Errorf("%s failed: expected unexpanded token error, got nil", t.Name())
+		return
+	}
+
+	full := tmpl.Expand(map[string]string{`ou`: `People`, `role`: `Auditor`})
+	want := `( target = "ldap:///ou=People,dc=example,dc=com || ldap:///ou=Staff,dc=example,dc=com" )( targetattr = "cn || sn" )(version 3.0; acl "People access"; allow(read) ( userdn = "ldap:///uid=*,ou=People,dc=example,dc=com || ldap:///anyone" AND NOT ( roledn = "ldap:///cn=Auditor,ou=Roles,dc=example,dc=com" ) AND ssf >= "128" );)`
+	if err := full.ValidStrict(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if got := full.String(); got != want {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), want, got)
+		return
+	}
+
+	if tmpl.String() != orig {
+		t.Errorf("%s failed: receiver was modified", t.Name())
+		return
+	}
+
+	var zero Instruction
+	if !zero.Expand(nil).IsZero() || len(zero.Tokens()) != 0 {
+		t.Errorf("%s failed: unexpected result from zero %T", t.Name(), zero)
+		return
+	}
+}
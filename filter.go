@@ -37,20 +37,21 @@ func (r SearchFilter) IsZero() bool {
 }
 
 /*
-Valid -- at the moment -- performs a naïve check on the receiver to determine whether
-the value is defined.  This method may, in the future, introduce more sophisticated
-checks to increase its value, such as counting (unescaped) parenthetical openers and
-closers to verify the effective 'balance' of the expression.
+Valid returns an error if the receiver is unset, or if its value does not appear to be
+a well-formed LDAP Search Filter per [RFC 4515]. Only the structure of the filter is
+verified, namely its parenthetical balance, its use of the AND (&), OR (|) and NOT (!)
+operators and the form of each item, including extensible match items such as those
+bearing `:dn:` or a matching rule (e.g.: `(cn:caseExactMatch:=John)`).
 
-For now, this method is little more than an inverse counterpart to IsZero that returns
-an instance of error instead of a Boolean.
+[RFC 4515]: https://datatracker.ietf.org/doc/html/rfc4515
 */
 func (r SearchFilter) Valid() (err error) {
 	if r.IsZero() {
 		err = nilInstanceErr(r)
+	} else if !isValidFilterSyntax(r.String()) {
+		err = badSearchFilterErr(r)
 	}
 
-	//TODO - add filter checks/decompiler? maybe. maybe not.
 	return
 }
 
@@ -80,6 +81,15 @@ func (r SearchFilter) TRM() TargetRuleMethods {
 	})
 }
 
+/*
+Rule returns a [TargetRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [TargetRule] is returned if op is not permitted for use with the receiver.
+
+See also the [SearchFilter.TRM] method.
+*/
+func (r SearchFilter) Rule(op any) TargetRule {
+	return r.TRM().rule(op)
+}
+
 /*
 Filter initializes (and optionally sets) a new instance of [SearchFilter].
 Instances of this kind are used in [LDAPURI] instances, as well as certain
@@ -156,6 +166,142 @@ func (r *searchFilter) set(x string) {
 	r.string = x
 }
 
+/*
+isValidFilterSyntax returns a Boolean value indicative of whether the input value appears to be a well-formed LDAP Search Filter, per [RFC 4515].
+
+Only the structure of the filter is verified, namely the parenthetical balance, the use of the AND (&), OR (|) and NOT (!) operators and the presence of an assertion within each item. Attribute types and assertion values are not checked for correctness.
+
+[RFC 4515]: https://datatracker.ietf.org/doc/html/rfc4515
+*/
+func isValidFilterSyntax(x string) bool {
+	next, ok := scanFilter(x, 0)
+	return ok && next == len(x)
+}
+
+/*
+scanFilter is a private recursive function called by isValidFilterSyntax. It reads a single parenthetical filter component beginning at index i of x, returning the index immediately following said component alongside a Boolean value indicative of success.
+*/
+func scanFilter(x string, i int) (next int, ok bool) {
+	if i >= len(x)-1 || x[i] != '(' {
+		return
+	}
+
+	switch x[i+1] {
+	case '&', '|':
+		next, ok = scanFilterSet(x, i+2)
+	case '!':
+		if next, ok = scanFilter(x, i+2); ok {
+			next, ok = closeFilter(x, next)
+		}
+	default:
+		next, ok = scanFilterItem(x, i+1)
+	}
+
+	return
+}
+
+/*
+scanFilterSet reads the zero (0) or more filter components that follow an AND (&) or OR (|) operator, as well as the closing parenthesis that concludes the set.
+*/
+func scanFilterSet(x string, i int) (next int, ok bool) {
+	next, ok = i, true
+	for ok && next < len(x) && x[next] == '(' {
+		next, ok = scanFilter(x, next)
+	}
+
+	if ok {
+		next, ok = closeFilter(x, next)
+	}
+
+	return
+}
+
+/*
+closeFilter verifies a closing parenthesis resides at index i of x.
+*/
+func closeFilter(x string, i int) (int, bool) {
+	if i < len(x) && x[i] == ')' {
+		return i + 1, true
+	}
+
+	return i, false
+}
+
+/*
+scanFilterItem reads a single filter item (e.g.: `objectClass=*`) beginning at index i of x, as well as the closing parenthesis that concludes it. The item must contain an equals sign (=) preceded by a non-zero attribute description that bears no whitespace.
+*/
+func scanFilterItem(x string, i int) (next int, ok bool) {
+	end := idxr(x[i:], ')')
+	if end == -1 {
+		return
+	}
+
+	item := x[i : i+end]
+	idx := idxr(item, '=')
+	if idx == -1 || contains(item, `(`) {
+		return
+	}
+
+	if idx > 0 && item[idx-1] == ':' {
+		// extensible match (e.g.: `cn:dn:caseExactMatch:=John`)
+		if !isValidExtensibleMatch(item[:idx-1]) {
+			return
+		}
+	} else if desc := trimR(item[:idx], `~<>`); len(desc) == 0 || contains(desc, ` `) {
+		return
+	}
+
+	next, ok = i+end+1, true
+	return
+}
+
+/*
+isValidExtensibleMatch returns a Boolean value indicative of whether the input value -- the portion of an extensible match filter item preceding the `:=` sequence -- is well-formed per [RFC 4515 Section 4]:
+
+	extensible = ( attr [dnattrs] [matchingrule] COLON EQUALS assertionvalue )
+	             / ( [dnattrs] matchingrule COLON EQUALS assertionvalue )
+
+Examples of valid input values include `cn:caseExactMatch`, `cn:dn`, `:dn:2.5.13.5` and `:1.2.840.113556.1.4.803`.
+
+[RFC 4515 Section 4]: https://datatracker.ietf.org/doc/html/rfc4515#section-4
+*/
+func isValidExtensibleMatch(x string) bool {
+	parts := split(x, `:`)
+	if len(parts) > 3 {
+		return false
+	}
+
+	// The attribute description is optional, but when
+	// absent, a matching rule MUST be specified.
+	if attr := parts[0]; len(attr) > 0 {
+		if !isIdentifier(attr) && !isDotNot(attr) {
+			return false
+		}
+	} else if len(parts) < 2 || eq(parts[len(parts)-1], `dn`) {
+		return false
+	}
+
+	for i := 1; i < len(parts); i++ {
+		switch {
+		case i == 1 && eq(parts[i], `dn`) && len(parts) <= 3:
+			// dnattrs (`:dn`) must precede any matching rule
+		case i == len(parts)-1 && isValidMatchingRule(parts[i]):
+			// matching rule (descriptor or numeric OID)
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+isValidMatchingRule returns a Boolean value indicative of whether x appears to be a matching rule descriptor (e.g.: `caseExactMatch`) or numeric OID (e.g.: `2.5.13.5`).
+*/
+func isValidMatchingRule(x string) bool {
+	return (isIdentifier(x) && !contains(x, `;`) && !eq(x, `dn`)) || isDotNot(x)
+}
+
 /*
 Eq initializes and returns a new [TargetRule] instance configured to express the evaluation of the receiver value as Equal-To a [TargetFilter] [TargetKeyword] context.
 */
@@ -355,7 +501,7 @@ Optionally, the caller may choose to submit one (1) or more (valid) instances of
 
 Instances of this design are not generally needed elsewhere.
 
-Values are automatically joined using [stackage.List] with [AttributeFilterOperations.SetDelimiter] for comma delimitation by default, though semicolon delimitation is also permitted.
+Values are automatically joined using [stackage.List] with [AttributeFilterOperations.SetDelimiter] for comma delimitation by default, though semicolon delimitation is also permitted. The default is governed by the [AttributeFilterOperationsDelim] global variable.
 */
 func AFOs(x ...any) (f AttributeFilterOperations) {
 	// create a native stackage.Stack
@@ -372,6 +518,9 @@ func AFOs(x ...any) (f AttributeFilterOperations) {
 	// instance* being created (f), thus allowing
 	// a custom presentation policy to be set.
 	f = AttributeFilterOperations(_f)
+	if AttributeFilterOperationsDelim != AttributeFilterOperationsCommaDelim {
+		f.SetDelimiter(AttributeFilterOperationsDelim)
+	}
 
 	// Set custom Presentation/Push policies
 	// per go-stackage signatures.
@@ -577,7 +726,19 @@ func (r AttributeFilterOperations) Kind() string {
 String is a stringer method that returns the string representation of the receiver instance.
 */
 func (r AttributeFilterOperations) String() string {
-	return r.cast().String()
+	if r.IsZero() {
+		return ``
+	}
+
+	// join the operations manually, as stackage
+	// would otherwise condense any whitespace
+	// found within the filter values.
+	var vals []string
+	for i := 0; i < r.Len(); i++ {
+		vals = append(vals, r.Index(i).String())
+	}
+
+	return join(vals, r.cast().Delimiter())
 }
 
 /*
@@ -615,6 +776,15 @@ func (r AttributeFilterOperations) TRM() TargetRuleMethods {
 	})
 }
 
+/*
+Rule returns a [TargetRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [TargetRule] is returned if op is not permitted for use with the receiver.
+
+See also the [AttributeFilterOperations.TRM] method.
+*/
+func (r AttributeFilterOperations) Rule(op any) TargetRule {
+	return r.TRM().rule(op)
+}
+
 /*
 pushPolicy conforms to [stackage.PushPolicy] closure signature. This method is used to govern attempts to push instances into a stack, allowing or rejecting  attempts based upon instance type and other conditions. An error is returned to the caller revealing the outcome of the attempt.
 */
@@ -846,10 +1016,21 @@ func (r AttributeFilterOperation) String() (s string) {
 	if r.IsZero() {
 		return
 	}
-	_r := r.cast()
-	_r.SetPresentationPolicy(nil)
-	s = sprintf("%s=%s", r.Operation(), _r.String())
-	_r.SetPresentationPolicy(r.presentationPolicy)
+
+	// join the filters manually, as stackage
+	// would otherwise condense any whitespace
+	// found within the filter values.
+	sym := `&&`
+	if r.cast().IsPadded() {
+		sym = ` && `
+	}
+
+	var vals []string
+	for i := 0; i < r.Len(); i++ {
+		vals = append(vals, r.Index(i).String())
+	}
+	s = sprintf("%s=%s", r.Operation(), join(vals, sym))
+
 	return
 }
 
@@ -897,6 +1078,15 @@ func (r AttributeFilterOperation) TRM() TargetRuleMethods {
 	})
 }
 
+/*
+Rule returns a [TargetRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [TargetRule] is returned if op is not permitted for use with the receiver.
+
+See also the [AttributeFilterOperation.TRM] method.
+*/
+func (r AttributeFilterOperation) Rule(op any) TargetRule {
+	return r.TRM().rule(op)
+}
+
 /*
 AFO returns a freshly initialized instance of [AttributeFilterOperation], configured to store one (1) or more [AttributeFilter] instances for the purpose of crafting [TargetRule] instances which bear the [TargetAttrFilters] [TargetKeyword] context. Instances of this design are not generally needed outside of that context.
 
@@ -1029,7 +1219,7 @@ func parseAttributeFilterOperations(raw string, delim int) (afos AttributeFilter
 	// initialize a new AttributeFilterOperations stack
 	// instance. Instances of AttributeFilterOperation
 	// shall be pushed into this.
-	afos = AFOs()
+	afos = AFOs().SetDelimiter(delim)
 
 	// iterate each of the above split string
 	// slices under the assumption that each
@@ -1039,7 +1229,7 @@ func parseAttributeFilterOperations(raw string, delim int) (afos AttributeFilter
 	for i := 0; i < len(vals) && err == nil; i++ {
 		var afo AttributeFilterOperation
 
-		value := unquote(condenseWHSP(vals[i]))
+		value := unquote(trimS(vals[i]))
 
 		// each of the slices created per the
 		// above char split should begin with
@@ -1061,6 +1251,58 @@ func parseAttributeFilterOperations(raw string, delim int) (afos AttributeFilter
 	return
 }
 
+/*
+splitAttributeFilterOperations splits raw into its constituent [AttributeFilterOperation] string values, returning them alongside the detected delimiter scheme, the first delimiter as written (including any surrounding whitespace) and an error instance.
+
+Only those commas (ASCII #44) or semicolons (ASCII #59) that are immediately followed -- ignoring whitespace -- by an `add=` or `delete=` marker are regarded as delimiters, thus any such characters appearing within attribute values are preserved. A raw value bearing a single operation (and, thus, no delimiter) is assigned the delimiter scheme governed by the [AttributeFilterOperationsDelim] global variable. Mixed delimiter schemes result in an error.
+*/
+func splitAttributeFilterOperations(raw string) (vals []string, delim int, sep string, err error) {
+	delim = -1
+	var start int
+	for i := 0; i < len(raw) && err == nil; i++ {
+		if raw[i] != ',' && raw[i] != ';' {
+			continue
+		} else if !hasAttributeFilterOperationPrefix(trimS(raw[i+1:])) {
+			continue
+		}
+
+		d := AttributeFilterOperationsCommaDelim
+		if raw[i] == ';' {
+			d = AttributeFilterOperationsSemiDelim
+		}
+
+		if delim != -1 && delim != d {
+			err = afosMixedDelimErr()
+		}
+
+		// widen the delimiter to include any
+		// surrounding whitespace, so that the
+		// original spacing may be preserved.
+		lo, hi := i, i+1
+		for lo > start && (raw[lo-1] == ' ' || raw[lo-1] == '\t') {
+			lo--
+		}
+		for hi < len(raw) && (raw[hi] == ' ' || raw[hi] == '\t') {
+			hi++
+		}
+		if delim == -1 {
+			sep = raw[lo:hi]
+		}
+
+		delim = d
+		vals = append(vals, raw[start:lo])
+		start = hi
+		i = hi - 1
+	}
+
+	if delim == -1 {
+		delim = AttributeFilterOperationsDelim
+	}
+	vals = append(vals, raw[start:])
+
+	return
+}
+
 /*
 parseAttributeFilterOperation parses the string input value (raw) and attempts to marshal its contents into an instance of AttributeFilterOperation (afo). An error is returned alongside afo upon completion of the attempt.
 */
@@ -1071,7 +1313,7 @@ func parseAttributeFilterOperation(raw string) (afo AttributeFilterOperation, er
 		seq []string
 	)
 
-	if raw = unquote(condenseWHSP(raw)); len(raw) < 5 {
+	if raw = unquote(trimS(raw)); len(raw) < 5 {
 		err = nilInstanceErr(afo)
 		return
 	}
@@ -1430,3 +1430,99 @@ func ExampleAttributeFilter_Compare() {
 	fmt.Printf("Hashes are equal: %t", f1.Compare(f2))
 	// Output: Hashes are equal: false
 }
+
+func ExampleParseAttributeFilterOperations() {
+	raw := `add=manager:(manager=uid=jesse,ou=People,dc=example,dc=com);delete=sn:(sn=*)`
+
+	afos, err := ParseAttributeFilterOperations(raw)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%d operations; lossless: %t", afos.Len(), afos.String() == raw)
+	// Output: 2 operations; lossless: true
+}
+
+func TestParseAttributeFilterOperations(t *testing.T) {
+	for idx, raw := range []string{
+		`add=manager:(manager=uid=jesse,ou=People,dc=example,dc=com) && cn:(cn=*),delete=sn:(sn=*)`,
+		`add=manager:(manager=uid=jesse,ou=People,dc=example,dc=com) && cn:(cn=*);delete=sn:(sn=*)`,
+		`add=description:(description=a,b;c),delete=description:(description=d;e,f)`,
+		`add=description:(description=a  b),delete=mail:(mail=*)`,
+		`add=description:(description=a  b);delete=mail:(mail=*)`,
+		`add=description:(description=a  b), delete=mail:(mail=*)`,
+		`add=description:(description=a  b); delete=mail:(mail=*)`,
+		`delete=cn:(cn=*)`,
+	} {
+		afos, err := ParseAttributeFilterOperations(raw)
+		if err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		} else if got := afos.String(); got != raw {
+			t.Errorf("%s[%d] failed:\nwant: %s\ngot:  %s", t.Name(), idx, raw, got)
+			return
+		}
+
+		// reparse the output to verify round-tripping
+		if again, _ := ParseAttributeFilterOperations(afos.String()); again.String() != raw {
+			t.Errorf("%s[%d] failed: round trip mismatch: %s", t.Name(), idx, again)
+			return
+		}
+	}
+
+	for idx, bogus := range []string{
+		``,
+		`cn:(cn=*)`,
+		`add=cn:(cn=*),delete=sn:(sn=*);add=mail:(mail=*)`,
+		`add=cn`,
+	} {
+		if _, err := ParseAttributeFilterOperations(bogus); err == nil {
+			t.Errorf("%s[%d] failed: expected error for '%s', got nil", t.Name(), idx, bogus)
+			return
+		}
+	}
+}
+
+func ExampleSearchFilter_Valid_extensibleMatch() {
+	for _, raw := range []string{
+		`(cn:caseExactMatch:=John)`,
+		`(:1.2.840.113556.1.4.803:=2)`,
+		`(cn:caseExactMatch:dn:=John)`,
+	} {
+		fmt.Println(Filter(raw).Valid() == nil)
+	}
+	// Output:
+	// true
+	// true
+	// false
+}
+
+func TestSearchFilter_Valid_extensible(t *testing.T) {
+	for idx, tc := range []struct {
+		raw   string
+		valid bool
+	}{
+		{`(cn:caseExactMatch:=John)`, true},
+		{`(:1.2.840.113556.1.4.803:=2)`, true},
+		{`(userAccountControl:1.2.840.113556.1.4.803:=2)`, true},
+		{`(ou:dn:=Sales)`, true},
+		{`(cn:dn:2.5.13.5:=John)`, true},
+		{`(:dn:2.5.13.5:=John)`, true},
+		{`(cn:=John)`, true},
+		{`(&(objectClass=person)(!(cn:caseIgnoreMatch:=x*)))`, true},
+		{`(:=John)`, false},
+		{`(:dn:=John)`, false},
+		{`(cn:dn:dn:=John)`, false},
+		{`(cn::=John)`, false},
+		{`(cn:a:b:c:=John)`, false},
+		{`(c n:caseExactMatch:=John)`, false},
+		{`(cn=John`, false},
+	} {
+		f := Filter(tc.raw)
+		if err := f.Valid(); (err == nil) != tc.valid {
+			t.Errorf("%s[%d] failed: %s: want valid %t, got %v", t.Name(), idx, tc.raw, tc.valid, err)
+			return
+		}
+	}
+}
@@ -4,6 +4,15 @@ package aci
 aci.go contains the top-level access control instructor methods and types.
 */
 
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"sort"
+)
+
 /*
 Version defines the official ACI syntax version number implemented and honored by this package.
 */
@@ -57,7 +66,8 @@ instruction is the embedded (pointer!) type found within initialized
 instances of the Instruction type. The fields are as follows:
 
 • N contains the string name (or "ACL") of a particular Instruction; note
-that this field cannot be reset for security reasons
+that this field cannot be reset for security reasons, except explicitly by
+way of Instruction.SetHeader
 
 • T contains one (1) TargetRules instance, which is a [stackage.Stack] type
 alias containing a sequence of zero (0) or more [TargetRule] instances
@@ -79,6 +89,11 @@ const (
 	badACI = `<invalid_aci>`
 )
 
+/*
+frozenKey is the auxiliary key under which a frozen Instructions instance stores its memoized string value.
+*/
+const frozenKey = `frozen`
+
 func (r Instructions) pushPolicy(x ...any) (err error) {
 	if r.contains(x[0]) {
 		err = pushErrorNotUnique(r, x[0], nil)
@@ -148,10 +163,113 @@ func (r Instructions) IsZero() bool {
 /*
 String is a stringer method that returns the string representation of the receiver instance.
 
-This method wraps the [stackage.Stack.String] method.
+The output is identical to that of the [stackage.Stack.String] method, though it is assembled more efficiently.
 */
 func (r Instructions) String() string {
-	return r.cast().String()
+	if s, ok := r.frozen(); ok {
+		return s
+	}
+	return r.string()
+}
+
+/*
+string is a private method called by Instructions.String and Instructions.Freeze.
+*/
+func (r Instructions) string() string {
+	if r.IsZero() {
+		return ``
+	}
+
+	return assembleList(r.Len(), '\n', func(i int) string {
+		return r.Index(i).String()
+	})
+}
+
+/*
+WriteTo implements the [io.WriterTo] interface. Each [Instruction] within the receiver is written to w in turn, followed by a newline (ASCII #10), using the same formatting as [Instructions.String]. This allows large exports to be streamed without materializing the entire string representation of the receiver in memory.
+
+If the receiver is frozen, its memoized string representation is written in a single operation instead.
+
+The total number of bytes written is returned, alongside any error encountered.
+*/
+func (r Instructions) WriteTo(w io.Writer) (n int64, err error) {
+	if s, ok := r.frozen(); ok {
+		return writeString(w, s+string(rune(10)))
+	}
+
+	var c int64
+	for i := 0; i < r.Len() && err == nil; i++ {
+		c, err = writeString(w, r.Index(i).String()+string(rune(10)))
+		n += c
+	}
+
+	return
+}
+
+/*
+writeString writes s to w, returning the number of bytes written as an int64 alongside any error encountered.
+*/
+func writeString(w io.Writer, s string) (int64, error) {
+	c, err := io.WriteString(w, s)
+	return int64(c), err
+}
+
+/*
+Freeze marks the receiver as read-only and memoizes its string representation, thereby eliminating the cost of repeated calls of the String method in situations where the receiver contains a large number of [Instruction] instances.
+
+While frozen, all attempts to modify the receiver (e.g.: Push or Pop) are refused. The memoized value is discarded upon execution of the [Instructions.Thaw] method, at which point the receiver is once again writable.
+
+Note that [Instruction] instances which reside within a frozen receiver should not be modified, as such changes shall not be reflected by the memoized value.
+*/
+func (r Instructions) Freeze() Instructions {
+	if r.IsZero() {
+		return r
+	}
+
+	_r := r.cast()
+	if _r.Auxiliary() == nil {
+		_r.SetAuxiliary()
+	}
+
+	_r.Auxiliary().Set(frozenKey, r.string())
+	_r.ReadOnly(true)
+
+	return r
+}
+
+/*
+Thaw discards the memoized string value created by the [Instructions.Freeze] method and restores the ability to modify the receiver.
+*/
+func (r Instructions) Thaw() Instructions {
+	if !r.IsZero() {
+		r.cast().Auxiliary().Unset(frozenKey)
+		r.cast().ReadOnly(false)
+	}
+
+	return r
+}
+
+/*
+IsFrozen returns a Boolean value indicative of whether the receiver was frozen using the [Instructions.Freeze] method.
+*/
+func (r Instructions) IsFrozen() bool {
+	_, ok := r.frozen()
+	return ok
+}
+
+/*
+frozen returns the memoized string value of the receiver alongside a Boolean value indicative of its presence.
+*/
+func (r Instructions) frozen() (s string, ok bool) {
+	if r.IsZero() {
+		return
+	}
+
+	if v, found := r.cast().Auxiliary().Get(frozenKey); found {
+		s, ok = v.(string)
+	}
+
+	return
 }
 
 /*
@@ -162,11 +280,327 @@ func (r Instruction) String() string {
 		return badACI
 	}
 
+	return r.render()
+}
+
+/*
+Value returns the receiver in the form to be sent to an X.500/LDAP DSA as a value of the 'aci' attribute type, such as within a modify or add operation. The return value is identical to that of [Instruction.String], and is guaranteed to occupy a single line, bearing no LDIF wrapping or folding of any kind. See [Instruction.PrettyString] for a multi-line form intended for human review only.
+
+A zero string is returned -- rather than an invalid value -- if the receiver is invalid, or if its string representation bears any line break (CR or LF) or NUL character, such as might be found within a carelessly assembled ACL label.
+*/
+func (r Instruction) Value() (v string) {
+	if err := r.Valid(); err == nil {
+		if v = r.render(); containsAny(v, "\r\n\x00") {
+			v = ``
+		}
+	}
+
+	return
+}
+
+/*
+render returns the string representation of the receiver without regard for validity.
+*/
+func (r Instruction) render() string {
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	buf.WriteString(r.instruction.TRs.String())
+	buf.WriteByte('(')
+	buf.WriteString(version()) // sprints Version const.
+	buf.WriteString(`; acl "`)
+	buf.WriteString(r.instruction.ACL)
+	buf.WriteString(`"; `)
+	buf.WriteString(r.instruction.PBRs.String())
+	buf.WriteByte(')')
+
+	return buf.String()
+}
+
+/*
+Fingerprint returns the lowercase hexadecimal SHA-256 digest of the receiver's canonical form, suitable for use as a stable map key or database identifier. A zero string is returned if the receiver is invalid.
+
+The canonical form is produced by reassembling the receiver under [ProfileDefault] conventions, thereby discarding cosmetic differences in operator padding, multivalued quotation and [AttributeFilterOperations] delimitation. [TargetRule] and [PermissionBindRule] instances are then sorted, as their order bears no significance, and case is folded only where it is likewise insignificant: within keywords, Boolean WORD operators and the attribute types of distinguished names. Values -- such as filters, attribute values and the values of RDNs -- as well as the ACL label, are used as-is.
+
+Note that this is a content hash intended for identification purposes only. It is NOT a security primitive and should not be used for integrity protection or authentication of any kind.
+*/
+func (r Instruction) Fingerprint() string {
+	canon := r.canonical()
+	if len(canon) == 0 {
+		return ``
+	}
+
+	sum := sha256.Sum256([]byte(canon))
+	return hex.EncodeToString(sum[:])
+}
+
+/*
+canonical returns the canonical string form of the receiver as described by [Instruction.Fingerprint], or a zero string if the receiver is invalid.
+*/
+func (r Instruction) canonical() string {
+	x, ok := r.renderFor(ProfileDefault)
+	if !ok {
+		return ``
+	}
+
+	trs, pbrs := x.canonicalRules()
 	return sprintf("%s(%s; acl \"%s\"; %s)",
-		r.instruction.TRs,
-		version(), // sprints Version const.
-		r.instruction.ACL,
-		r.instruction.PBRs)
+		trs,
+		version(),
+		x.instruction.ACL,
+		pbrs)
+}
+
+/*
+canonicalRules returns the case-folded (see canonicalRule) and sorted [TargetRule] and [PermissionBindRule] instances of the receiver, each joined as a single string value.
+*/
+func (r Instruction) canonicalRules() (string, string) {
+	trs := make([]string, r.instruction.TRs.Len())
+	for i := 0; i < len(trs); i++ {
+		trs[i] = canonicalRule(r.instruction.TRs.Index(i).String())
+	}
+	sort.Strings(trs)
+
+	pbrs := make([]string, r.instruction.PBRs.Len())
+	for i := 0; i < len(pbrs); i++ {
+		pbrs[i] = canonicalRule(r.instruction.PBRs.Index(i).String())
+	}
+	sort.Strings(pbrs)
+
+	return join(trs, ``), join(pbrs, ` `)
+}
+
+/*
+canonicalRule returns the rendered rule(s) raw with case folded only where it bears no significance: keywords are lowercased, Boolean WORD operators are uppercased and the attribute types of distinguished name values are lowercased. All other values, such as filters, attribute values and DN attribute values, are left as written. raw is returned as-is if it cannot be tokenized.
+*/
+func canonicalRule(raw string) string {
+	tokens, err := Tokenize(raw)
+	if err != nil {
+		return raw
+	}
+
+	var (
+		out  []byte
+		last int
+		kw   string
+	)
+	for _, t := range tokens {
+		out = append(out, raw[last:t.Pos]...)
+		last = t.Pos + len(t.String())
+
+		switch t.Kind {
+		case KeywordToken:
+			kw = lc(t.Value)
+			out = append(out, kw...)
+		case BooleanToken:
+			out = append(out, uc(t.Value)...)
+		case ValueToken:
+			v := t.Value
+			switch kw {
+			case Target.String(), TargetTo.String(), TargetFrom.String(),
+				BindUDN.String(), BindGDN.String(), BindRDN.String():
+				v = foldDNTypes(v)
+			}
+			out = append(out, '"')
+			out = append(out, v...)
+			out = append(out, '"')
+		default:
+			out = append(out, t.Value...)
+		}
+	}
+
+	return string(append(out, raw[last:]...))
+}
+
+/*
+foldDNTypes returns the one (1) or more symbolic OR (||) delimited distinguished names (or LDAP URIs) within x with the attribute type of each RDN -- as well as the LDAP scheme and any DN alias, such as "anyone" -- lowercased. RDN values, and any URI component following the DN, are left as written.
+*/
+func foldDNTypes(x string) string {
+	b := []byte(x)
+	inType := true
+	for i := 0; i < len(b); i++ {
+		switch c := b[i]; {
+		case c == '\\':
+			i++ // skip escaped character
+		case c == '|':
+			inType = true
+		case c == '?' && !inType:
+			// URI components follow; skip to the next value, if any.
+			for i+1 < len(b) && b[i+1] != '|' {
+				i++
+			}
+		case inType && c == '=':
+			inType = false
+		case !inType && (c == ',' || c == '+'):
+			inType = true
+		case inType && 'A' <= c && c <= 'Z':
+			b[i] = c + ('a' - 'A')
+		}
+	}
+
+	return string(b)
+}
+
+/*
+EqualIgnoringName returns a Boolean value indicative of whether the receiver and x are semantically equal, without regard for their ACL labels. This is useful when detecting drift between authored and deployed instances, in which labels may change independently of the access they describe.
+
+The [TargetRule] and [PermissionBindRule] instances of each are canonicalized as described by [Instruction.Fingerprint] -- discarding cosmetic differences in padding, quotation, case and order -- and the resultant SHA-256 digests are compared. False is returned if either instance is invalid.
+*/
+func (r Instruction) EqualIgnoringName(x Instruction) bool {
+	a, b := r.rulesDigest(), x.rulesDigest()
+	return len(a) > 0 && a == b
+}
+
+/*
+invertedSuffix is appended to the ACL label of an [Instruction] by the [Instruction.ToDeny] method.
+*/
+const invertedSuffix = ` (inverted)`
+
+/*
+ToDeny returns a new instance of [Instruction] in which the disposition of every [PermissionBindRule] has been flipped using [Permission.Flip]: allow becomes deny, and vice versa. The [TargetRule] and [BindRule] instances of the receiver are shared with the return instance as-is.
+
+The ACL label of the return instance bears an ` (inverted)` suffix, distinguishing it from the receiver. Should the receiver's label already bear this suffix, it is removed instead, thus inverting an inverted instance yields the original label.
+
+The receiver is not modified. A bogus (zero) [Instruction] is returned if the receiver is invalid.
+*/
+func (r Instruction) ToDeny() (x Instruction) {
+	if r.Valid() != nil {
+		return
+	}
+
+	acl := r.instruction.ACL
+	if hasSfx(acl, invertedSuffix) {
+		acl = acl[:len(acl)-len(invertedSuffix)]
+	} else {
+		acl += invertedSuffix
+	}
+
+	x = ACI(acl)
+	for i := 0; i < r.instruction.TRs.Len(); i++ {
+		x.instruction.TRs.Push(r.instruction.TRs.Index(i))
+	}
+
+	for i := 0; i < r.instruction.PBRs.Len(); i++ {
+		pbr := r.instruction.PBRs.Index(i)
+		x.instruction.PBRs.Push(PBR(pbr.Permission().Flip(), pbr.BindRules()))
+	}
+
+	if x.Valid() != nil {
+		x = Instruction{}
+	}
+
+	return
+}
+
+/*
+rulesDigest returns the SHA-256 digest of the canonical [TargetRule] and [PermissionBindRule] instances of the receiver, or a zero string if the receiver is invalid.
+*/
+func (r Instruction) rulesDigest() string {
+	x, ok := r.renderFor(ProfileDefault)
+	if !ok {
+		return ``
+	}
+
+	trs, pbrs := x.canonicalRules()
+	sum := sha256.Sum256([]byte(trs + `(` + version() + `; ` + pbrs + `)`))
+	return hex.EncodeToString(sum[:])
+}
+
+/*
+PrettyString is a stringer method that returns a multi-line string representation of the receiver instance. Each [TargetRule] is written upon its own line, and each nested [BindRules] stack found within any [PermissionBindRule] shall increase the indentation by one (1) level.
+
+The return value is intended for human review and diffing purposes only; it is NOT suitable for submission to an X.500/LDAP DSA. Use the [Instruction.String] method for that purpose.
+*/
+func (r Instruction) PrettyString() string {
+	if err := r.Valid(); err != nil {
+		return badACI
+	}
+
+	var lines []string
+	for i := 0; i < r.instruction.TRs.Len(); i++ {
+		lines = append(lines, r.instruction.TRs.Index(i).String())
+	}
+
+	lines = append(lines, `(`, sprintf("%s%s; acl \"%s\";",
+		prettyIndent(1), version(), r.instruction.ACL))
+
+	for i := 0; i < r.instruction.PBRs.Len(); i++ {
+		pbr := r.instruction.PBRs.Index(i)
+		if pbr.IsZero() {
+			continue
+		}
+
+		lines = append(lines, prettyIndent(1)+pbr.P.String())
+		B := prettyBindContext(pbr.B, 1, ``)
+		if len(B) > 0 {
+			B[len(B)-1] += `;`
+		}
+		lines = append(lines, B...)
+	}
+
+	lines = append(lines, `)`)
+
+	return join(lines, string(rune(10)))
+}
+
+/*
+prettyWordPrefix returns the Boolean WORD operator prefix for the child (sub) found at index idx within a parent stack bearing the (uppercase) WORD operator word. Negated stacks are further prefixed using `NOT`, as is done during normal string representation.
+*/
+func prettyWordPrefix(word string, idx int, sub BindContext) (pfx string) {
+	if idx > 0 {
+		pfx = word + ` `
+	}
+
+	if sub != nil && sub.Kind() == `stack` {
+		if eq(sub.Category(), `not`) {
+			pfx += `NOT `
+		}
+	}
+
+	return
+}
+
+/*
+prettyIndent returns the whitespace indentation for the given depth.
+*/
+func prettyIndent(depth int) string {
+	return rept(`  `, depth)
+}
+
+/*
+prettyBindContext is a private recursive function called by Instruction.PrettyString. It returns the lines that comprise the indented representation of ctx, each prefixed with the given depth of indentation. The pfx input value, if non-zero, is the Boolean WORD operator that precedes ctx within its parent stack.
+*/
+func prettyBindContext(ctx BindContext, depth int, pfx string) (lines []string) {
+	if ctx == nil || ctx.IsZero() {
+		return
+	}
+
+	pad := prettyIndent(depth)
+	if ctx.Kind() != `stack` {
+		lines = append(lines, pad+pfx+ctx.String())
+		return
+	}
+
+	// only emit an opening line when there
+	// is something to put upon it.
+	if ctx.IsParen() {
+		lines = append(lines, pad+pfx+`(`)
+	} else if len(pfx) > 0 {
+		lines = append(lines, pad+trimS(pfx))
+	}
+
+	word := uc(ctx.Category())
+	for i := 0; i < ctx.Len(); i++ {
+		sub := ctx.Index(i)
+		lines = append(lines, prettyBindContext(sub, depth+1,
+			prettyWordPrefix(word, i, sub))...)
+	}
+
+	if ctx.IsParen() {
+		lines = append(lines, pad+`)`)
+	}
+
+	return
 }
 
 /*
@@ -215,75 +649,843 @@ func (r Instructions) F() func(...any) Instruction {
 }
 
 /*
-Valid wraps the [stackage.Stack.Valid] method.
+Valid wraps the [stackage.Stack.Valid] method. Additionally, an error is returned if any ACL name is used by more than one (1) [Instruction] within the receiver. See [Instructions.DuplicateACLNames].
 */
 func (r Instructions) Valid() (err error) {
-	err = r.cast().Valid()
+	if err = r.cast().Valid(); err == nil {
+		if dups := r.DuplicateACLNames(); len(dups) > 0 {
+			err = duplicateACLNamesErr(dups)
+		}
+	}
 	return
 }
 
 /*
-Index wraps the [stackage.Stack.Index] method. Note that the Boolean OK value returned by [stackage] by default will be shadowed and not obtainable by the caller.
+DuplicateACLNames returns slices of ACL names used by more than one (1) [Instruction] within the receiver. Each duplicate name is returned once, in the form in which it first appeared. Case is not significant in the matching process.
+
+Note that while [Instructions.Push] rejects identical [Instruction] instances, it does not reject different instances which merely share an ACL name.
 */
-func (r Instructions) Index(idx int) (x Instruction) {
-	y, _ := r.cast().Index(idx)
-	if assert, ok := y.(Instruction); ok {
-		x = assert
+func (r Instructions) DuplicateACLNames() (dups []string) {
+	first := make(map[string]string)
+	counts := make(map[string]int)
+	for i := 0; i < r.Len(); i++ {
+		acl := r.Index(i).ACL()
+		if len(acl) == 0 {
+			continue
+		}
+
+		key := lc(acl)
+		if counts[key]++; counts[key] == 1 {
+			first[key] = acl
+		} else if counts[key] == 2 {
+			dups = append(dups, first[key])
+		}
 	}
 
 	return
 }
 
 /*
-T returns the [TargetRules] instance found within the underlying receiver instance. Note that a bogus [TargetRules] instance is returned if the receiver is nil, or unset.
-*/
-func (r Instruction) TRs() (trs TargetRules) {
-	if !r.IsZero() {
-		trs = r.instruction.TRs
-	}
+ValidateAll returns slices of error, one (1) per invalid [Instruction] found within the receiver instance. Each error identifies the index of the offending [Instruction]. Valid [Instruction] instances produce no error entry, thus a zero length return indicates total validity.
 
-	return
+See [Instructions.ValidateAllContext] for a variant that honors cancellation.
+*/
+func (r Instructions) ValidateAll() []error {
+	return r.ValidateAllContext(context.Background())
 }
 
 /*
-PBRs returns the [PermissionBindRules] instance found within the underlying receiver instance. Note that a bogus [PermissionBindRules] instance is returned if the receiver is nil, or unset.
+ValidateAllContext is identical to [Instructions.ValidateAll], except that the process shall be aborted upon cancellation (or expiry) of the input [context.Context] instance. In such a case, the context error is appended as the final error slice and the return is made immediately.
 */
-func (r Instruction) PBRs() (pbrs PermissionBindRules) {
-	if !r.IsZero() {
-		pbrs = r.instruction.PBRs
+func (r Instructions) ValidateAllContext(ctx context.Context) (errs []error) {
+	for i := 0; i < r.Len(); i++ {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+
+		if err := r.Index(i).validate(); err != nil {
+			errs = append(errs, instructionIndexErr(i, err))
+		}
 	}
+
 	return
 }
 
 /*
-ACL returns the access control label of the receiver, else a zero string if unset.
+OverlappingTargets returns index pairs of [Instruction] instances within the receiver whose [Target] DN patterns overlap. Two patterns overlap if one describes the same entry as, or an ancestor of, the other, or if their wildcard (*) RDN values could match a common entry, e.g.:
+
+	uid=*,ou=People,dc=example,dc=com
+	uid=jesse,ou=People,dc=example,dc=com
+
+Only equality-based [Target] [TargetRule] instances are considered. An [Instruction] lacking such a rule is never reported. This method is intended to aid in the auditing of unintentionally broad grants.
 */
-func (r Instruction) ACL() (acl string) {
-	if !r.IsZero() {
-		acl = r.instruction.ACL
+func (r Instructions) OverlappingTargets() (pairs [][2]int) {
+	targets := make([][]string, r.Len())
+	for i := 0; i < len(targets); i++ {
+		targets[i] = r.Index(i).targetDNs()
+	}
+
+	for i := 0; i < len(targets); i++ {
+		for j := i + 1; j < len(targets); j++ {
+			if anyDNPatternsOverlap(targets[i], targets[j]) {
+				pairs = append(pairs, [2]int{i, j})
+			}
+		}
 	}
+
 	return
 }
 
 /*
-Valid returns an instance of error that reflects any perceived errors or deficiencies within the receiver instance.
+anyDNPatternsOverlap returns a Boolean value indicative of whether any DN pattern within a overlaps with any DN pattern within b.
 */
-func (r Instruction) Valid() (err error) {
-	if r.IsZero() {
-		err = nilInstanceErr(r)
+func anyDNPatternsOverlap(a, b []string) bool {
+	for i := 0; i < len(a); i++ {
+		for j := 0; j < len(b); j++ {
+			if dnPatternsOverlap(a[i], b[j]) {
+				return true
+			}
+		}
 	}
-	return
+
+	return false
 }
 
 /*
-IsZero returns a Boolean value indicative of whether the receiver is nil, or unset.
+Conflict describes a pairing of [Instruction] instances, one (1) of which grants -- and the other withholds -- one or more of the same [Right] instances for the same target. The fields are as follows:
+
+  - Allow contains the index of the granting [Instruction]
+  - Deny contains the index of the withholding [Instruction]
+  - Rights contains the [Right] instances both [Instruction] instances address
+
+See the [Instructions.Conflicts] method.
 */
-func (r Instruction) IsZero() bool {
-	return r.instruction.isZero()
+type Conflict struct {
+	Allow  int
+	Deny   int
+	Rights []Right
 }
 
-func (r *instruction) isZero() bool {
-	return r == nil
+/*
+Conflicts returns slices of [Conflict], each describing a pair of [Instruction] instances within the receiver in which one [Instruction] allows -- and the other denies -- an intersecting set of [Right] instances for the same target.
+
+A conservative overlap model is used: two [Instruction] instances are considered to share a target only if they bear at least one (1) identical equality-based [Target] DN pattern (without regard to case or the LDAP scheme prefix), or if neither bears such a rule and both therefore apply to the entry upon which they reside. Bind rules are not compared, thus any two [Instruction] instances meeting the above criteria are assumed to potentially apply to the same principal.
+
+Conflicts are returned in index order of the granting [Instruction].
+*/
+func (r Instructions) Conflicts() (conflicts []Conflict) {
+	targets := make([][]string, r.Len())
+	allow, deny := make([]Right, r.Len()), make([]Right, r.Len())
+	for i := 0; i < r.Len(); i++ {
+		targets[i] = normalizedTargetDNs(r.Index(i))
+		allow[i], deny[i] = r.Index(i).dispositionRights()
+	}
+
+	for i := 0; i < len(targets); i++ {
+		for j := 0; j < len(targets); j++ {
+			if i == j || !sameTargetDNs(targets[i], targets[j]) {
+				continue
+			}
+
+			if rights := rightsSlice(allow[i] & deny[j]); len(rights) > 0 {
+				conflicts = append(conflicts, Conflict{Allow: i, Deny: j, Rights: rights})
+			}
+		}
+	}
+
+	return
+}
+
+/*
+dispositionRights returns the aggregate granting (allow) and withholding (deny) [Right] bits of all [PermissionBindRule] instances found within the receiver.
+*/
+func (r Instruction) dispositionRights() (allow, deny Right) {
+	pbrs := r.PBRs()
+	for i := 0; i < pbrs.Len(); i++ {
+		perm := pbrs.Index(i).Permission()
+		if perm.Valid() != nil {
+			continue
+		}
+
+		bits := perm.bits()
+		if pbrs.Index(i).Disposition() {
+			allow |= bits
+		} else {
+			deny |= bits
+		}
+	}
+
+	return
+}
+
+/*
+rightsSlice returns slices of [Right], each representing a single bit that is positive within bits.
+*/
+func rightsSlice(bits Right) (rights []Right) {
+	for i := 0; i < 10; i++ {
+		if right := Right(1 << i); bits&right != 0 {
+			rights = append(rights, right)
+		}
+	}
+
+	return
+}
+
+/*
+normalizedTargetDNs returns the lowercased, prefix-free equality-based [Target] DN patterns of the input [Instruction].
+*/
+func normalizedTargetDNs(i Instruction) (dns []string) {
+	for _, dn := range i.targetDNs() {
+		dns = append(dns, lc(trimS(chopDNPfx(dn))))
+	}
+
+	return
+}
+
+/*
+sameTargetDNs returns a Boolean value indicative of whether a and b share at least one (1) normalized DN pattern, or whether both are empty.
+*/
+func sameTargetDNs(a, b []string) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+
+	for i := 0; i < len(a); i++ {
+		if strInSlice(a[i], b) {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+Coalesce returns a new instance of [Instructions] in which receiver slices bearing identical [TargetRules] and compatible ACL labels have been merged into a single [Instruction] containing all of their respective [PermissionBindRule] instances. This is, in effect, the inverse of [Instruction.Split].
+
+[TargetRules] are considered identical if their canonical forms -- the lowercased string value of each [TargetRule], sorted -- are identical. ACL labels are considered compatible if they are equal without regard to case once any index suffix produced by [Instruction.Split] (e.g.: " #2") has been removed. A merged [Instruction] bears the label of its first member, minus any such suffix.
+
+Slices that cannot be merged with any other slice are passed through unchanged. The relative order of the receiver's slices is preserved.
+*/
+func (r Instructions) Coalesce() (merged Instructions) {
+	merged = ACIs()
+
+	var keys []string
+	groups := make(map[string][]Instruction)
+	for i := 0; i < r.Len(); i++ {
+		ins := r.Index(i)
+		if ins.IsZero() {
+			continue
+		}
+
+		key := ins.coalesceKey()
+		if _, found := groups[key]; !found {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], ins)
+	}
+
+	for _, key := range keys {
+		merged.Push(coalesceInstructions(groups[key]))
+	}
+
+	return
+}
+
+/*
+coalesceInstructions returns a single [Instruction] bearing the [TargetRules] of the first slice of group, as well as the [PermissionBindRule] instances of all slices. Single-member groups are returned unchanged.
+*/
+func coalesceInstructions(group []Instruction) Instruction {
+	if len(group) == 1 {
+		return group[0]
+	}
+
+	ins := ACI(aclBaseName(group[0].ACL()), group[0].TRs())
+	for i := 0; i < len(group); i++ {
+		ins.Set(group[i].PBRs())
+	}
+
+	return ins
+}
+
+/*
+coalesceKey returns the grouping key used by [Instructions.Coalesce], which combines the receiver's lowercased ACL base name and its canonicalized [TargetRules].
+*/
+func (r Instruction) coalesceKey() string {
+	trs := make([]string, r.instruction.TRs.Len())
+	for i := 0; i < len(trs); i++ {
+		trs[i] = lc(r.instruction.TRs.Index(i).String())
+	}
+	sort.Strings(trs)
+
+	return lc(aclBaseName(r.instruction.ACL)) + string(rune(0)) + join(trs, ``)
+}
+
+/*
+aclBaseName returns the input ACL label minus any numerical index suffix (e.g.: " #2") produced by [Instruction.Split].
+*/
+func aclBaseName(acl string) string {
+	base := trimR(acl, `0123456789`)
+	if base != acl && hasSfx(base, ` #`) {
+		return base[:len(base)-2]
+	}
+
+	return acl
+}
+
+/*
+Index wraps the [stackage.Stack.Index] method. Note that the Boolean OK value returned by [stackage] by default will be shadowed and not obtainable by the caller.
+*/
+func (r Instructions) Index(idx int) (x Instruction) {
+	y, _ := r.cast().Index(idx)
+	if assert, ok := y.(Instruction); ok {
+		x = assert
+	}
+
+	return
+}
+
+/*
+T returns the [TargetRules] instance found within the underlying receiver instance. Note that a bogus [TargetRules] instance is returned if the receiver is nil, or unset.
+*/
+func (r Instruction) TRs() (trs TargetRules) {
+	if !r.IsZero() {
+		trs = r.instruction.TRs
+	}
+
+	return
+}
+
+/*
+PBRs returns the [PermissionBindRules] instance found within the underlying receiver instance. Note that a bogus [PermissionBindRules] instance is returned if the receiver is nil, or unset.
+*/
+func (r Instruction) PBRs() (pbrs PermissionBindRules) {
+	if !r.IsZero() {
+		pbrs = r.instruction.PBRs
+	}
+	return
+}
+
+/*
+ACL returns the access control label of the receiver, else a zero string if unset.
+*/
+func (r Instruction) ACL() (acl string) {
+	if !r.IsZero() {
+		acl = r.instruction.ACL
+	}
+	return
+}
+
+/*
+Header describes the `(version 3.0; acl "name";` header of an [Instruction], grouping the syntax version and the access control label.
+
+A zero Version field is interpreted as the [Version] constant, which is the only version honored by this package.
+*/
+type Header struct {
+	Version float32
+	ACL     string
+}
+
+/*
+String returns the string representation of the receiver, e.g.:
+
+	version 3.0; acl "name";
+*/
+func (r Header) String() string {
+	v := r.Version
+	if v == 0 {
+		v = Version
+	}
+
+	return sprintf("version %.1f; acl \"%s\";", v, r.ACL)
+}
+
+/*
+Valid returns an instance of error in the event the receiver bears an unsupported Version or a zero ACL label.
+*/
+func (r Header) Valid() (err error) {
+	if r.Version != 0 && r.Version != Version {
+		err = headerVersionErr(r.Version)
+	} else if len(r.ACL) == 0 {
+		err = headerACLErr()
+	}
+
+	return
+}
+
+/*
+Header returns an instance of [Header] describing the version and access control label of the receiver. A zero [Header] is returned if the receiver is nil, or unset.
+*/
+func (r Instruction) Header() (h Header) {
+	if !r.IsZero() {
+		h = Header{Version: Version, ACL: r.instruction.ACL}
+	}
+
+	return
+}
+
+/*
+SetHeader replaces the version and access control label of the receiver with those of h in one shot, returning an error if h is invalid, in which case the receiver is not modified.
+
+Unlike [Instruction.Set], which shall not rename an [Instruction] once labeled, SetHeader replaces any existing label. This is intended for callers assembling instances from external metadata.
+*/
+func (r *Instruction) SetHeader(h Header) (err error) {
+	if err = h.Valid(); err == nil {
+		if r.instruction == nil {
+			r.instruction = newACI()
+		}
+		r.instruction.ACL = h.ACL
+	}
+
+	return
+}
+
+/*
+Valid returns an instance of error that reflects any perceived errors or deficiencies within the receiver instance.
+
+An error is returned if any slice within the [TargetRules] of the receiver is not a [TargetRule] bearing a [TargetKeyword]. This guards against malformed output from custom assembly paths.
+*/
+func (r Instruction) Valid() (err error) {
+	if r.IsZero() {
+		err = nilInstanceErr(r)
+	} else if r.instruction.isEmpty() {
+		err = instructionNoLabelErr()
+	} else {
+		err = r.ordered()
+	}
+	return
+}
+
+/*
+ordered returns an error if any slice within the receiver's [TargetRules] is not a [TargetRule] bearing a [TargetKeyword].
+*/
+func (r Instruction) ordered() (err error) {
+	trs := r.instruction.TRs
+	for i := 0; i < trs.Len(); i++ {
+		tr := trs.Index(i)
+		if tr.IsZero() || matchTKW(tr.Keyword().String()) == TargetKeyword(0x0) {
+			err = instructionOrderErr(i)
+			break
+		}
+	}
+
+	return
+}
+
+/*
+targetDNs returns the string DN values of all equality-based [Target] [TargetRule] instances found within the receiver.
+*/
+func (r Instruction) targetDNs() (dns []string) {
+	if r.IsZero() {
+		return
+	}
+
+	for i := 0; i < r.instruction.TRs.Len(); i++ {
+		tr := r.instruction.TRs.Index(i)
+		if tr.Keyword() == Target && tr.Operator() == Eq {
+			dns = append(dns, targetRuleDNs(tr)...)
+		}
+	}
+
+	return
+}
+
+/*
+TargetDNs returns the string DN values referenced by all [Target], [TargetTo] and [TargetFrom] [TargetRule] instances found within the receiver, regardless of [ComparisonOperator]. Multi-valued rules are split into their individual DN values, and the LDAP scheme prefix (ldap:///) is removed from each. DN aliases, such as "ldap:///anyone", are returned as-is.
+
+The return order is that of the [TargetRules] within the receiver. A nil slice is returned if the receiver is nil, unset or lacks any of the above rules.
+*/
+func (r Instruction) TargetDNs() (dns []string) {
+	if r.IsZero() {
+		return
+	}
+
+	for i := 0; i < r.instruction.TRs.Len(); i++ {
+		tr := r.instruction.TRs.Index(i)
+		switch tr.Keyword() {
+		case Target, TargetTo, TargetFrom:
+			for _, dn := range targetRuleDNs(tr) {
+				dns = append(dns, chopTargetDNPfx(dn))
+			}
+		}
+	}
+
+	return
+}
+
+/*
+targetRuleDNs returns the string DN values of the [TargetDistinguishedName] or [TargetDistinguishedNames] expression of the input [TargetRule].
+*/
+func targetRuleDNs(tr TargetRule) (dns []string) {
+	switch tv := tr.Expression().(type) {
+	case TargetDistinguishedName:
+		dns = append(dns, tv.String())
+	case TargetDistinguishedNames:
+		for j := 0; j < tv.Len(); j++ {
+			dns = append(dns, tv.Index(j).String())
+		}
+	}
+
+	return
+}
+
+/*
+chopTargetDNPfx returns dn minus its LDAP scheme prefix, unless dn describes a DN alias (e.g.: "ldap:///anyone"), in which case it is returned unmodified.
+*/
+func chopTargetDNPfx(dn string) string {
+	bare := chopDNPfx(dn)
+	if strInSlice(lc(bare), []string{aliasAnyone, aliasAll, aliasSelf, aliasParent}) {
+		return dn
+	}
+
+	return bare
+}
+
+/*
+Split returns an instance of [Instructions] containing one (1) [Instruction] per [PermissionBindRule] found within the receiver. This is useful when dealing with directory products that only tolerate a single permission/bind rule pair per ACI value.
+
+Each return [Instruction] bears the [TargetRules] of the receiver, as well as an ACL label derived from that of the receiver. If the receiver contains more than one (1) [PermissionBindRule], each label is suffixed with its one-based index (e.g.: "Label #2"). Otherwise, the label is unchanged.
+
+An empty [Instructions] instance is returned if the receiver is nil, or unset.
+*/
+func (r Instruction) Split() (split Instructions) {
+	split = ACIs()
+	if r.IsZero() {
+		return
+	}
+
+	n := r.instruction.PBRs.Len()
+	for i := 0; i < n; i++ {
+		acl := r.instruction.ACL
+		if n > 1 {
+			acl = sprintf("%s #%d", acl, i+1)
+		}
+
+		split.Push(ACI(acl, r.instruction.TRs, r.instruction.PBRs.Index(i)))
+	}
+
+	return
+}
+
+/*
+Condition is a flattened record of a single leaf condition -- that is, a single [TargetRule] or [BindRule] -- found within an [Instruction]. Instances of this type are produced by the [Instruction.Conditions] method.
+
+Scope is either "target" or "bind", depending on the origin of the condition.
+
+Depth is the number of [BindRules] stacks enclosing the condition. Conditions with a Scope of "target" are always at a Depth of zero (0), as are [BindRule] instances that comprise the entirety of a [PermissionBindRule]'s bind context.
+
+Negated is true if the condition is enclosed by an odd number of Boolean NOT stacks. The Operator field always reflects the [ComparisonOperator] as written.
+*/
+type Condition struct {
+	Scope      string
+	Keyword    Keyword
+	Operator   ComparisonOperator
+	Expression string
+	Depth      int
+	Negated    bool
+}
+
+/*
+Conditions returns slices of [Condition], each representing a single leaf condition found within the receiver. All [TargetRule] instances are returned first, followed by the [BindRule] instances of each [PermissionBindRule], in the order in which they appear.
+
+A nil slice is returned if the receiver is nil, or unset.
+*/
+func (r Instruction) Conditions() (conds []Condition) {
+	if r.IsZero() {
+		return
+	}
+
+	for i := 0; i < r.instruction.TRs.Len(); i++ {
+		tr := r.instruction.TRs.Index(i)
+		conds = append(conds, Condition{
+			Scope:      `target`,
+			Keyword:    tr.Keyword(),
+			Operator:   tr.Operator(),
+			Expression: sprintf("%s", tr.Expression()),
+		})
+	}
+
+	for i := 0; i < r.instruction.PBRs.Len(); i++ {
+		conds = bindConditions(conds, r.instruction.PBRs.Index(i).BindRules(), 0, false)
+	}
+
+	return
+}
+
+/*
+bindConditions appends a [Condition] to conds for each [BindRule] found within b, recursing through any nested [BindRules] instances.
+*/
+func bindConditions(conds []Condition, b BindContext, depth int, negated bool) []Condition {
+	switch tv := b.(type) {
+	case BindRule:
+		if !tv.IsZero() {
+			conds = append(conds, Condition{
+				Scope:      `bind`,
+				Keyword:    tv.Keyword(),
+				Operator:   tv.Operator(),
+				Expression: sprintf("%s", tv.Expression()),
+				Depth:      depth,
+				Negated:    negated,
+			})
+		}
+	case BindRules:
+		neg := negated != eq(tv.Category(), `not`)
+		for i := 0; i < tv.Len(); i++ {
+			conds = bindConditions(conds, tv.Index(i), depth+1, neg)
+		}
+	}
+
+	return conds
+}
+
+/*
+Principal describes a single DN value or DN alias referenced by a [BindRule] bearing the [BindUDN], [BindGDN] or [BindRDN] [BindKeyword]. Instances of this type are produced by the [Instruction.TaggedPrincipals] method. The fields are as follows:
+
+  - Keyword identifies whether DN refers to a user, a group or a role
+  - Operator reflects the [ComparisonOperator] of the referencing [BindRule], as written
+  - Negated is true if the referencing [BindRule] is enclosed by an odd number of Boolean NOT stacks
+  - DN contains the value less its LDAP scheme prefix, e.g.: `cn=Admins,ou=Groups,dc=example,dc=com` or `anyone`
+
+A Principal bearing the Ne [ComparisonOperator], or which is Negated (but not both), describes those to whom the referencing [BindRule] does NOT apply.
+*/
+type Principal struct {
+	Keyword  BindKeyword
+	Operator ComparisonOperator
+	Negated  bool
+	DN       string
+}
+
+/*
+String returns the string representation of the receiver, e.g.:
+
+	groupdn = cn=Admins,ou=Groups,dc=example,dc=com
+
+A Negated receiver is prefixed with `NOT `.
+*/
+func (r Principal) String() (s string) {
+	s = sprintf("%s %s %s", r.Keyword, r.Operator, r.DN)
+	if r.Negated {
+		s = `NOT ` + s
+	}
+
+	return
+}
+
+/*
+Principals returns the unique DN values and DN aliases referenced by all [BindUDN], [BindGDN] and [BindRDN] [BindRule] instances found within the receiver, at any depth, less the LDAP scheme prefix. In the case of an [LDAPURI] value, only its base DN is returned. Case is not significant in determining uniqueness, and values are returned in the order in which they first appear.
+
+See [Instruction.TaggedPrincipals] for a variant which identifies the nature of each value.
+*/
+func (r Instruction) Principals() (dns []string) {
+	seen := make(map[string]bool)
+	for _, p := range r.TaggedPrincipals() {
+		if key := lc(p.DN); !seen[key] {
+			seen[key] = true
+			dns = append(dns, p.DN)
+		}
+	}
+
+	return
+}
+
+/*
+TaggedPrincipals returns a unique [Principal] instance for each DN value or DN alias referenced by all [BindUDN], [BindGDN] and [BindRDN] [BindRule] instances found within the receiver, at any depth. This is useful for access review purposes, such as determining who may access a given entry.
+
+In the case of an [LDAPURI] value, such as `ldap:///ou=People,dc=example,dc=com??sub?(title=manager)`, the DN of the return instance is the base DN of the URI. Note that such a rule matches only those entries beneath the base DN which satisfy the filter, and not the base DN itself.
+
+Case is not significant in determining uniqueness, and instances are returned in the order in which they first appear.
+*/
+func (r Instruction) TaggedPrincipals() (principals []Principal) {
+	if r.IsZero() {
+		return
+	}
+
+	seen := make(map[string]bool)
+	for i := 0; i < r.instruction.PBRs.Len(); i++ {
+		for _, p := range bindPrincipals(nil, r.instruction.PBRs.Index(i).BindRules(), false) {
+			if key := lc(p.String()); !seen[key] {
+				seen[key] = true
+				principals = append(principals, p)
+			}
+		}
+	}
+
+	return
+}
+
+/*
+bindPrincipals is the recursive backend of the [Instruction.TaggedPrincipals] method.
+*/
+func bindPrincipals(principals []Principal, b BindContext, negated bool) []Principal {
+	switch tv := b.(type) {
+	case BindRule:
+		kw, _ := tv.Keyword().(BindKeyword)
+		if kw != BindUDN && kw != BindGDN && kw != BindRDN {
+			break
+		}
+
+		for _, dn := range principalDNs(tv.Expression()) {
+			principals = append(principals, Principal{
+				Keyword:  kw,
+				Operator: tv.Operator(),
+				Negated:  negated,
+				DN:       chopDNPfx(dn),
+			})
+		}
+	case BindRules:
+		neg := negated != eq(tv.Category(), `not`)
+		for i := 0; i < tv.Len(); i++ {
+			principals = bindPrincipals(principals, tv.Index(i), neg)
+		}
+	}
+
+	return principals
+}
+
+/*
+principalDNs returns the string DN values of the input [BindRule] expression. In the case of an LDAP URI, whether an [LDAPURI] or a parsed DN value bearing URI components, only the base DN is returned, as the remaining components describe a search rather than an identity.
+*/
+func principalDNs(expr any) (dns []string) {
+	switch tv := expr.(type) {
+	case BindDistinguishedName:
+		dns = append(dns, uriBaseDN(tv.String()))
+	case BindDistinguishedNames:
+		for i := 0; i < tv.Len(); i++ {
+			dns = append(dns, uriBaseDN(tv.Index(i).String()))
+		}
+	case LDAPURI:
+		if !tv.IsZero() && !tv.ldapURI.dn.IsZero() {
+			dns = append(dns, tv.ldapURI.dn.String())
+		}
+	}
+
+	return
+}
+
+/*
+uriBaseDN returns x less any LDAP URI components (attributes, scope and filter) following the first question mark (ASCII #63).
+*/
+func uriBaseDN(x string) string {
+	if idx := idxr(x, '?'); idx != -1 {
+		x = x[:idx]
+	}
+
+	return x
+}
+
+/*
+OperatorHistogram returns a map of each [ComparisonOperator] used by the [TargetRule] and [BindRule] instances found within the receiver -- at any depth -- alongside the number of conditions bearing said operator. This is useful for policy metrics, such as spotting overuse of [Ne] or unusual numerical comparisons.
+
+Operators that are not used are absent from the return map. An empty map is returned if the receiver is nil, or unset. See also [Instructions.OperatorHistogram].
+*/
+func (r Instruction) OperatorHistogram() (h map[ComparisonOperator]int) {
+	h = make(map[ComparisonOperator]int)
+	r.operatorHistogram(h)
+	return
+}
+
+/*
+operatorHistogram is a private method called by [Instruction.OperatorHistogram] and [Instructions.OperatorHistogram]. Counts are added to h.
+*/
+func (r Instruction) operatorHistogram(h map[ComparisonOperator]int) {
+	if r.IsZero() {
+		return
+	}
+
+	for i := 0; i < r.instruction.TRs.Len(); i++ {
+		if cop := r.instruction.TRs.Index(i).Operator(); cop != badCop {
+			h[cop]++
+		}
+	}
+
+	for i := 0; i < r.instruction.PBRs.Len(); i++ {
+		bindOperators(h, r.instruction.PBRs.Index(i).BindRules())
+	}
+}
+
+/*
+OperatorHistogram returns a map of each [ComparisonOperator] used within all [Instruction] instances found within the receiver, alongside the total number of conditions bearing said operator. See [Instruction.OperatorHistogram] for details.
+*/
+func (r Instructions) OperatorHistogram() (h map[ComparisonOperator]int) {
+	h = make(map[ComparisonOperator]int)
+	for i := 0; i < r.Len(); i++ {
+		r.Index(i).operatorHistogram(h)
+	}
+
+	return
+}
+
+/*
+bindOperators is the recursive backend of the [Instruction.OperatorHistogram] method.
+*/
+func bindOperators(h map[ComparisonOperator]int, b BindContext) {
+	switch tv := b.(type) {
+	case BindRule:
+		if cop := tv.Operator(); cop != badCop {
+			h[cop]++
+		}
+	case BindRules:
+		for i := 0; i < tv.Len(); i++ {
+			bindOperators(h, tv.Index(i))
+		}
+	}
+}
+
+/*
+validate is a private method called by [Instructions.ValidateAllContext]. In addition to the basic checks performed by [Instruction.Valid], the receiver must bear an ACL label, valid [TargetRule] instances and at least one (1) valid [PermissionBindRule].
+*/
+func (r Instruction) validate() (err error) {
+	if err = r.Valid(); err != nil {
+		return
+	}
+
+	if len(r.instruction.ACL) == 0 {
+		err = instructionNoLabelErr()
+	} else if r.instruction.PBRs.Len() == 0 {
+		err = instructionNoPBRsErr()
+	} else {
+		for i := 0; i < r.instruction.TRs.Len() && err == nil; i++ {
+			err = r.instruction.TRs.Index(i).Valid()
+		}
+		for i := 0; i < r.instruction.PBRs.Len() && err == nil; i++ {
+			err = r.instruction.PBRs.Index(i).Valid()
+		}
+	}
+
+	return
+}
+
+/*
+IsZero returns a Boolean value indicative of whether the receiver is nil, or unset.
+*/
+func (r Instruction) IsZero() bool {
+	return r.instruction.isZero()
+}
+
+func (r *instruction) isZero() bool {
+	return r == nil
+}
+
+/*
+isEmpty returns a Boolean value indicative of whether the receiver bears neither an ACL label nor any [PermissionBindRule] instances, as is the case following a call of [Instruction.Reset].
+*/
+func (r *instruction) isEmpty() bool {
+	return len(r.ACL) == 0 && r.PBRs.Len() == 0
+}
+
+/*
+Reset clears all components of the receiver -- the ACL label, [TargetRules] and [PermissionBindRules] -- such that it may be reused, for instance within a loop, without further allocation. The version is fixed per the [Version] constant, and is therefore unaffected.
+
+Following a call of this method, the receiver remains non-zero -- thus the [TargetRules] and [PermissionBindRules] returned by [Instruction.TRs] and [Instruction.PBRs] may be pushed to directly -- but shall fail the [Instruction.Valid] check until such time as it is repopulated, such as through [Instruction.Set], which may assign a new ACL label.
+*/
+func (r *Instruction) Reset() {
+	if r.instruction == nil {
+		return
+	}
+
+	r.instruction.ACL = ``
+	r.instruction.TRs.reset()
+	r.instruction.PBRs.cast().Reset()
 }
 
 /*
@@ -297,11 +1499,20 @@ Input values must conform to the following specifications per the intended field
 
 Please note the following constraints for the name of the receiver:
 
-  - Value cannot be reset (i.e.: renamed)
+  - Value cannot be reset (i.e.: renamed), except explicitly by way of [Instruction.SetHeader]
   - Value should not contain the "version <float>" statement, as that is imposed automatically during string representation procedures
+
+A [PaddingOption], created using the [WithPadding] function, may also be submitted to override the [RulePadding] and [StackPadding] global variables for all rules of the return instance.
 */
-func ACI(x ...any) Instruction {
-	return Instruction{newACI(x...)}
+func ACI(x ...any) (i Instruction) {
+	x, opt := paddingOption(x)
+	i = Instruction{newACI(x...)}
+
+	if opt != nil {
+		applyPadding(i, opt.pad)
+	}
+
+	return
 }
 
 /*
@@ -336,6 +1547,44 @@ func (r *Instruction) Set(x ...any) *Instruction {
 	return r
 }
 
+/*
+PushPBRString parses each raw string value as a [PermissionBindRule], e.g.:
+
+	allow(read,search) userdn = "ldap:///anyone";
+
+Each resultant [PermissionBindRule] is validated and appended to the receiver. This is useful in situations where the [TargetRule] instances are at hand as objects, but the permission bind rules exist only in their serialized form.
+
+Fragments which fail to parse, fail validity checks or are not unique are not appended, and are reported individually within the (joined) error return, each identified by its index. All other fragments are appended regardless.
+*/
+func (r *Instruction) PushPBRString(raw ...string) error {
+	if r.instruction == nil {
+		r.instruction = newACI()
+	}
+
+	var errs []error
+	for i := 0; i < len(raw); i++ {
+		if err := r.instruction.pushPBRString(raw[i]); err != nil {
+			errs = append(errs, pbrStringErr(i, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+/*
+pushPBRString is a private method called by Instruction.PushPBRString.
+*/
+func (r *instruction) pushPBRString(raw string) (err error) {
+	var pbr PermissionBindRule
+	if pbr, err = parsePermissionBindRule(raw); err == nil {
+		if err = r.PBRs.pushPolicy(pbr); err == nil {
+			r.PBRs.Push(pbr)
+		}
+	}
+
+	return
+}
+
 /*
 set is a private method invoked by newACI and Instruction.Set to handle the addition of new ACI components through type assertion and validity checks where applicable.
 */
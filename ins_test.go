@@ -1,7 +1,12 @@
 package aci
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"os"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -337,6 +342,356 @@ func ExampleInstruction_String() {
 	// Output: ( target = "ldap:///uid=*,ou=People,dc=example,dc=com" )(version 3.0; acl "Limit people access to timeframe"; allow(read,search,compare) ( ( timeofday >= "1730" AND timeofday < "2400" ) AND ( userdn = "ldap:///uid=jesse,ou=admin,dc=example,dc=com" OR userdn = "ldap:///uid=courtney,ou=admin,dc=example,dc=com" ) AND NOT ( userattr = "ninja#FALSE" ) );)
 }
 
+func ExampleInstruction_Value() {
+	i := ACI(`Anonymous read`,
+		TRs(TAs(`cn`, `sn`).Eq()),
+		PBR(Allow(ReadAccess, SearchAccess), Anyone().Eq()),
+	)
+	fmt.Println(i.Value())
+	// Output: ( targetattr = "cn || sn" )(version 3.0; acl "Anonymous read"; allow(read,search) userdn = "ldap:///anyone";)
+}
+
+func TestInstruction_Value(t *testing.T) {
+	pbr := PBR(Allow(ReadAccess), Anyone().Eq())
+
+	i := ACI(`Valid`, TRs(TDN(`ou=People,dc=example,dc=com`).Eq(), Subtree.Eq()), pbr)
+	if got := i.Value(); got != i.String() || strings.ContainsAny(got, "\r\n") {
+		t.Errorf("%s failed: unexpected value %q", t.Name(), got)
+		return
+	}
+
+	for idx, bogus := range []Instruction{
+		{},
+		ACI("Line\nbreak", pbr),
+		ACI("Carriage\rreturn", pbr),
+		ACI("Nul\x00byte", pbr),
+	} {
+		if got := bogus.Value(); got != `` {
+			t.Errorf("%s[%d] failed: want zero string, got %q", t.Name(), idx, got)
+			return
+		}
+	}
+}
+
+func ExampleInstruction_PrettyString() {
+	t := TDN(`uid=*,ou=People,dc=example,dc=com`).Eq()
+	tgt := TRs().Push(t)
+
+	userat := UAT(AT(`ninja`), AV(`FALSE`))
+	ors := Or().Paren().Push(
+		UDN(`uid=jesse,ou=admin,dc=example,dc=com`).Eq(),
+		UDN(`uid=courtney,ou=admin,dc=example,dc=com`).Eq(),
+	)
+	nots := Not().Paren().Push(userat.Eq())
+	brule := And().Paren().Push(
+		Timeframe(
+			ToD(`1730`),
+			ToD(`2400`),
+		).Paren(),
+		ors,
+		nots,
+	)
+
+	pbr := PBR(Allow(ReadAccess, SearchAccess, CompareAccess), brule)
+	acl := `Limit people access to timeframe`
+
+	var i Instruction
+	i.Set(acl, tgt, pbr)
+
+	fmt.Printf("%s", i.PrettyString())
+	// Output:
+	// ( target = "ldap:///uid=*,ou=People,dc=example,dc=com" )
+	// (
+	//   version 3.0; acl "Limit people access to timeframe";
+	//   allow(read,search,compare)
+	//   (
+	//     (
+	//       timeofday >= "1730"
+	//       AND timeofday < "2400"
+	//     )
+	//     AND (
+	//       userdn = "ldap:///uid=jesse,ou=admin,dc=example,dc=com"
+	//       OR userdn = "ldap:///uid=courtney,ou=admin,dc=example,dc=com"
+	//     )
+	//     AND NOT (
+	//       userattr = "ninja#FALSE"
+	//     )
+	//   );
+	// )
+}
+
+func ExampleInstruction_Fingerprint() {
+	a := ACI(`Read names`,
+		TRs().Push(TAs(`cn`, `sn`).Eq(), TDN(`ou=People,dc=example,dc=com`).Eq()),
+		PBR(Allow(ReadAccess), UDN(`uid=jesse,ou=admin,dc=example,dc=com`).Eq()),
+	)
+
+	// Same rules, different order, padding and DN attribute type case.
+	b := ACI(`Read names`,
+		TRs().Push(TDN(`OU=People,DC=example,DC=com`).Eq().NoPadding(true), TAs(`cn`, `sn`).Eq()),
+		PBR(Allow(ReadAccess), UDN(`UID=jesse,ou=admin,dc=example,dc=com`).Eq().NoPadding(true)),
+	)
+
+	fmt.Println(a.Fingerprint() == b.Fingerprint(), len(a.Fingerprint()))
+	// Output: true 64
+}
+
+func TestInstruction_Fingerprint(t *testing.T) {
+	tdn := TDN(`ou=People,dc=example,dc=com`).Eq()
+	read := PBR(Allow(ReadAccess), UDN(`uid=jesse,ou=admin,dc=example,dc=com`).Eq())
+	deny := PBR(Deny(WriteAccess), AnyDN.Eq())
+
+	a := ACI(`Fingerprint`, TRs().Push(tdn), read, deny)
+	b := ACI(`Fingerprint`, TRs().Push(tdn), deny, read)
+	if fa, fb := a.Fingerprint(), b.Fingerprint(); fa != fb {
+		t.Errorf("%s failed: PBR order altered fingerprint:\n%s\n%s", t.Name(), fa, fb)
+		return
+	} else if fa != a.Fingerprint() {
+		t.Errorf("%s failed: fingerprint is not deterministic", t.Name())
+		return
+	}
+
+	for idx, other := range []Instruction{
+		ACI(`Other label`, TRs().Push(tdn), read, deny),
+		ACI(`Fingerprint`, TRs().Push(tdn), read),
+		ACI(`Fingerprint`, TRs().Push(TDN(`ou=Groups,dc=example,dc=com`).Eq()), read, deny),
+		ACI(`Fingerprint`, TRs().Push(tdn), deny,
+			PBR(Allow(ReadAccess), UDN(`uid=Jesse,ou=admin,dc=example,dc=com`).Eq())),
+	} {
+		if a.Fingerprint() == other.Fingerprint() {
+			t.Errorf("%s[%d] failed: distinct instructions share a fingerprint", t.Name(), idx)
+			return
+		}
+	}
+
+	var zero Instruction
+	if fp := zero.Fingerprint(); fp != `` {
+		t.Errorf("%s failed: expected zero string for zero %T, got %s", t.Name(), zero, fp)
+	}
+}
+
+func TestCanonicalRule(t *testing.T) {
+	for raw, want := range map[string]string{
+		`( TargetFilter = "(CN=Foo)" )`:                                       `( targetfilter = "(CN=Foo)" )`,
+		`userdn = "LDAP:///UID=Jesse,OU=People?cn,sn?one?(CN=Foo)"`:           `userdn = "ldap:///uid=Jesse,ou=People?cn,sn?one?(CN=Foo)"`,
+		`target = "ldap:///CN=A\,B,DC=x || ldap:///DC=Y"`:                     `target = "ldap:///cn=A\,B,dc=x || ldap:///dc=Y"`,
+		`userattr = "Manager#USERDN" and groupdn = "ldap:///CN=X+UID=Y,DC=z"`: `userattr = "Manager#USERDN" AND groupdn = "ldap:///cn=X+uid=Y,dc=z"`,
+	} {
+		if got := canonicalRule(raw); got != want {
+			t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), want, got)
+		}
+	}
+}
+
+func ExampleInstruction_EqualIgnoringName() {
+	var authored, deployed Instruction
+	_ = authored.Parse(`( targetattr = "cn || sn" )(version 3.0; acl "Read names"; allow(read) userdn = "ldap:///anyone";)`)
+	_ = deployed.Parse(`(targetattr="cn || sn")(version 3.0; acl "Legacy name"; allow(read) userdn="ldap:///anyone";)`)
+	fmt.Printf("Equal: %t", authored.EqualIgnoringName(deployed))
+	// Output: Equal: true
+}
+
+func ExampleInstruction_ToDeny() {
+	var i Instruction
+	_ = i.Parse(`( targetattr = "cn || sn" )(version 3.0; acl "Read names"; allow(read,search) userdn = "ldap:///anyone";)`)
+	fmt.Println(i.ToDeny())
+	// Output: ( targetattr = "cn || sn" )(version 3.0; acl "Read names (inverted)"; deny(read,search) userdn = "ldap:///anyone";)
+}
+
+func TestInstruction_ToDeny(t *testing.T) {
+	tdn := TDN(`ou=People,dc=example,dc=com`).Eq()
+	i := ACI(`Mixed`, TRs(tdn),
+		PBR(Allow(ReadAccess), UDN(`uid=jesse,ou=admin,dc=example,dc=com`).Eq()),
+		PBR(Deny(WriteAccess), AnyDN.Eq()),
+	)
+	orig := i.String()
+
+	inv := i.ToDeny()
+	if err := inv.Valid(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if inv.ACL() != `Mixed (inverted)` {
+		t.Errorf("%s failed: unexpected label '%s'", t.Name(), inv.ACL())
+		return
+	} else if i.String() != orig {
+		t.Errorf("%s failed: receiver was modified", t.Name())
+		return
+	}
+
+	pbrs := inv.PBRs()
+	if pbrs.Index(0).Permission().IsAllow() || !pbrs.Index(1).Permission().IsAllow() {
+		t.Errorf("%s failed: dispositions not flipped: %s", t.Name(), pbrs)
+		return
+	} else if inv.TRs().String() != i.TRs().String() {
+		t.Errorf("%s failed: target rules altered: %s", t.Name(), inv.TRs())
+		return
+	}
+
+	if back := inv.ToDeny(); back.String() != orig {
+		t.Errorf("%s failed: double inversion mismatch:\nwant: %s\ngot:  %s",
+			t.Name(), orig, back)
+		return
+	}
+
+	var zero Instruction
+	if !zero.ToDeny().IsZero() {
+		t.Errorf("%s failed: zero instance yielded non-zero inversion", t.Name())
+	}
+}
+
+func TestInstruction_EqualIgnoringName(t *testing.T) {
+	tdn := TDN(`ou=People,dc=example,dc=com`).Eq()
+	read := PBR(Allow(ReadAccess), UDN(`uid=jesse,ou=admin,dc=example,dc=com`).Eq())
+	deny := PBR(Deny(WriteAccess), AnyDN.Eq())
+
+	a := ACI(`Authored`, TRs(tdn), read, deny)
+	if !a.EqualIgnoringName(ACI(`Deployed`, TRs(tdn), deny, read)) {
+		t.Errorf("%s failed: label or order affected equality", t.Name())
+		return
+	}
+
+	for idx, other := range []Instruction{
+		ACI(`Authored`, TRs(tdn), read),
+		ACI(`Authored`, TRs(TDN(`ou=Groups,dc=example,dc=com`).Eq()), read, deny),
+		ACI(`Authored`, TRs(tdn), read, PBR(Deny(AllAccess), AnyDN.Eq())),
+		ACI(`Authored`, TRs(TDN(`ou=people,dc=example,dc=com`).Eq()), read, deny),
+		{},
+	} {
+		if a.EqualIgnoringName(other) {
+			t.Errorf("%s[%d] failed: distinct instructions considered equal", t.Name(), idx)
+			return
+		}
+	}
+
+	var zero Instruction
+	if zero.EqualIgnoringName(zero) {
+		t.Errorf("%s failed: zero instances considered equal", t.Name())
+	}
+}
+
+func ExampleInstruction_OperatorHistogram() {
+	i := ACI(`Business hours`,
+		TAs(`cn`, `sn`).Ne(),
+		PBR(Allow(ReadAccess), And().Push(
+			Anyone().Eq(),
+			ToD(`0800`).Ge(),
+			ToD(`1700`).Lt(),
+		)),
+	)
+	fmt.Println(i.OperatorHistogram())
+	// Output: map[=:1 !=:1 <:1 >=:1]
+}
+
+func TestInstruction_OperatorHistogram(t *testing.T) {
+	var parsed Instruction
+	if err := parsed.Parse(`(target="ldap:///ou=People,dc=example,dc=com")(targetattr!="userPassword")(version 3.0; acl "Parsed"; allow(read) (userdn="ldap:///anyone" AND (ssf>="128" AND authmethod!="none"));)`); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	built := ACI(`Built`,
+		PBR(Deny(WriteAccess), Not().Push(UDN(`uid=jesse,ou=People,dc=example,dc=com`).Eq())),
+		PBR(Allow(ReadAccess), Or().Push(
+			SSF(56).Gt(),
+			And().Paren().Push(GDN(`cn=Staff,dc=example,dc=com`).Eq(), ToD(`1800`).Le()),
+		)),
+	)
+
+	for idx, tc := range []struct {
+		got  map[ComparisonOperator]int
+		want map[ComparisonOperator]int
+	}{
+		{parsed.OperatorHistogram(), map[ComparisonOperator]int{Eq: 2, Ne: 2, Ge: 1}},
+		{built.OperatorHistogram(), map[ComparisonOperator]int{Eq: 2, Gt: 1, Le: 1}},
+		{ACIs(parsed, built).OperatorHistogram(), map[ComparisonOperator]int{Eq: 4, Ne: 2, Ge: 1, Gt: 1, Le: 1}},
+		{Instruction{}.OperatorHistogram(), map[ComparisonOperator]int{}},
+	} {
+		if len(tc.got) != len(tc.want) {
+			t.Errorf("%s[%d] failed: want %v, got %v", t.Name(), idx, tc.want, tc.got)
+			return
+		}
+		for cop, n := range tc.want {
+			if tc.got[cop] != n {
+				t.Errorf("%s[%d] failed: want %v, got %v", t.Name(), idx, tc.want, tc.got)
+				return
+			}
+		}
+	}
+}
+
+func ExampleInstruction_Principals() {
+	i := ACI(`Admins and self`,
+		PBR(Allow(AllAccess), Or().Push(
+			GDN(`cn=Admins,ou=Groups,dc=example,dc=com`).Eq(),
+			Self().Eq(),
+		)),
+	)
+	fmt.Println(i.Principals())
+	// Output: [cn=Admins,ou=Groups,dc=example,dc=com self]
+}
+
+func TestInstruction_TaggedPrincipals(t *testing.T) {
+	i := ACI(`Principals`,
+		PBR(Allow(ReadAccess), Or().Push(
+			And().Paren().Push(Anyone().Eq(), GDNs(`cn=A,dc=example,dc=com`, `cn=B,dc=example,dc=com`).Eq()),
+			And().Paren().Push(RDN(`cn=R,dc=example,dc=com`).Eq(), Not().Push(UDN(`uid=bad,dc=example,dc=com`).Eq())),
+		)),
+		PBR(Deny(WriteAccess), And().Push(
+			GDN(`CN=A,dc=example,dc=com`).Eq(),
+			UDN(`uid=bad,dc=example,dc=com`).Ne(),
+			SSF(128).Ge(),
+		)),
+	)
+
+	want := []Principal{
+		{BindUDN, Eq, false, `anyone`},
+		{BindGDN, Eq, false, `cn=A,dc=example,dc=com`},
+		{BindGDN, Eq, false, `cn=B,dc=example,dc=com`},
+		{BindRDN, Eq, false, `cn=R,dc=example,dc=com`},
+		{BindUDN, Eq, true, `uid=bad,dc=example,dc=com`},
+		{BindUDN, Ne, false, `uid=bad,dc=example,dc=com`},
+	}
+
+	got := i.TaggedPrincipals()
+	if len(got) != len(want) {
+		t.Errorf("%s failed: want %d principals, got %d: %v", t.Name(), len(want), len(got), got)
+		return
+	}
+	for j := range want {
+		if got[j] != want[j] {
+			t.Errorf("%s[%d] failed:\nwant: %s\ngot:  %s", t.Name(), j, want[j], got[j])
+			return
+		}
+	}
+
+	if dns := i.Principals(); len(dns) != 5 {
+		t.Errorf("%s failed: want 5 unique DNs, got %d: %v", t.Name(), len(dns), dns)
+		return
+	}
+
+	// only the base DN of an LDAP URI is returned
+	var u Instruction
+	if err := u.Parse(`(targetattr = "cn")(version 3.0; acl "Managers"; allow(read) userdn = "ldap:///ou=People,dc=example,dc=com??sub?(title=manager)";)`); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if dns := u.Principals(); len(dns) != 1 || dns[0] != `ou=People,dc=example,dc=com` {
+		t.Errorf("%s failed: unexpected URI principals: %v", t.Name(), dns)
+		return
+	}
+
+	uri := URI(`ldap:///ou=People,dc=example,dc=com?cn?one?(title=manager)`)
+	u = ACI(`Managers`, PBR(Allow(ReadAccess), uri.Eq()))
+	if dns := u.Principals(); len(dns) != 1 || dns[0] != `ou=People,dc=example,dc=com` {
+		t.Errorf("%s failed: unexpected %T principals: %v", t.Name(), uri, dns)
+		return
+	}
+
+	var zero Instruction
+	if p := zero.TaggedPrincipals(); len(p) != 0 {
+		t.Errorf("%s failed: unexpected principals from zero %T: %v", t.Name(), zero, p)
+	}
+}
+
 func ExampleInstruction_TRs() {
 	t := TDN(`uid=*,ou=People,dc=example,dc=com`).Eq()
 	tgt := TRs().Push(t)
@@ -372,6 +727,189 @@ func ExampleInstruction_TRs() {
 	// Output: ( target = "ldap:///uid=*,ou=People,dc=example,dc=com" )
 }
 
+func ExampleInstruction_Split() {
+	var i Instruction
+	i.Set(`Self-service`,
+		TRs().Push(TAs(`cn`, `sn`).Eq()),
+		PBR(Allow(ReadAccess), SelfDN.Eq()),
+		PBR(Allow(WriteAccess), SelfDN.Eq()),
+	)
+
+	fmt.Println(i.Split())
+	// Output:
+	// ( targetattr = "cn || sn" )(version 3.0; acl "Self-service #1"; allow(read) userdn = "ldap:///self";)
+	// ( targetattr = "cn || sn" )(version 3.0; acl "Self-service #2"; allow(write) userdn = "ldap:///self";)
+}
+
+func TestInstruction_Split(t *testing.T) {
+	var i Instruction
+	if split := i.Split(); split.Len() != 0 {
+		t.Errorf("%s failed: want empty %T, got %d", t.Name(), split, split.Len())
+		return
+	}
+
+	i.Set(`single`, PBR(Allow(ReadAccess), AnyDN.Eq()))
+	split := i.Split()
+	if split.Len() != 1 || split.Index(0).ACL() != `single` {
+		t.Errorf("%s failed: unexpected split: %s", t.Name(), split)
+		return
+	}
+
+	i = ACI(`multi`,
+		PBR(Allow(ReadAccess), AnyDN.Eq()),
+		PBR(Deny(WriteAccess), AnyDN.Eq()),
+		PBR(Allow(SearchAccess), SelfDN.Eq()),
+	)
+	split = i.Split()
+	if split.Len() != 3 {
+		t.Errorf("%s failed: want 3 %T, got %d", t.Name(), i, split.Len())
+		return
+	}
+
+	if errs := split.ValidateAll(); len(errs) > 0 {
+		t.Errorf("%s failed: %v", t.Name(), errs)
+		return
+	}
+}
+
+func ExampleInstructions_Coalesce() {
+	tgt := TRs().Push(TAs(`cn`, `sn`).Eq())
+	acis := ACIs(
+		ACI(`Self-service #1`, tgt, PBR(Allow(ReadAccess), SelfDN.Eq())),
+		ACI(`Self-service #2`, tgt, PBR(Allow(WriteAccess), SelfDN.Eq())),
+	)
+
+	fmt.Println(acis.Coalesce())
+	// Output: ( targetattr = "cn || sn" )(version 3.0; acl "Self-service"; allow(read) userdn = "ldap:///self"; allow(write) userdn = "ldap:///self";)
+}
+
+func TestInstructions_Coalesce(t *testing.T) {
+	orig := ACI(`Multi`,
+		TRs().Push(TDN(`ou=People,dc=example,dc=com`).Eq(), TAs(`cn`).Eq()),
+		PBR(Allow(ReadAccess), AnyDN.Eq()),
+		PBR(Deny(WriteAccess), AnyDN.Eq()),
+		PBR(Allow(SearchAccess), SelfDN.Eq()),
+	)
+
+	// round trip through Split
+	merged := orig.Split().Coalesce()
+	if merged.Len() != 1 || merged.Index(0).String() != orig.String() {
+		t.Errorf("%s failed: round trip mismatch:\nwant: %s\ngot:  %s", t.Name(), orig, merged)
+		return
+	}
+
+	// target rule order is not significant
+	other := ACI(`multi #9`,
+		TRs().Push(TAs(`cn`).Eq(), TDN(`ou=People,dc=example,dc=com`).Eq()),
+		PBR(Allow(CompareAccess), AnyDN.Eq()),
+	)
+	if merged = ACIs(orig, other).Coalesce(); merged.Len() != 1 || merged.Index(0).PBRs().Len() != 4 {
+		t.Errorf("%s failed: unexpected merge result: %s", t.Name(), merged)
+		return
+	}
+
+	// differing labels or targets pass through unchanged
+	unrelated := ACI(`Unrelated`, orig.TRs(), PBR(Allow(ReadAccess), SelfDN.Eq()))
+	retargeted := ACI(`Multi`, TRs().Push(TAs(`sn`).Eq()), PBR(Allow(ReadAccess), SelfDN.Eq()))
+	if merged = ACIs(orig, unrelated, retargeted).Coalesce(); merged.Len() != 3 {
+		t.Errorf("%s failed: want 3 %T, got %d", t.Name(), orig, merged.Len())
+		return
+	} else if merged.Index(1).String() != unrelated.String() {
+		t.Errorf("%s failed: order not preserved: %s", t.Name(), merged)
+		return
+	}
+
+	if merged = ACIs().Coalesce(); merged.Len() != 0 {
+		t.Errorf("%s failed: want empty, got %d", t.Name(), merged.Len())
+		return
+	}
+}
+
+func ExampleInstructions_DuplicateACLNames() {
+	acis := ACIs(
+		ACI(`Allow anonymous reads`, PBR(Allow(ReadAccess), AnyDN.Eq())),
+		ACI(`Allow self writes`, PBR(Allow(WriteAccess), SelfDN.Eq())),
+		ACI(`allow anonymous READS`, PBR(Allow(SearchAccess), AnyDN.Eq())),
+	)
+
+	fmt.Println(acis.DuplicateACLNames())
+	// Output: [Allow anonymous reads]
+}
+
+func TestInstructions_DuplicateACLNames(t *testing.T) {
+	acis := ACIs(
+		ACI(`A`, PBR(Allow(ReadAccess), AnyDN.Eq())),
+		ACI(`B`, PBR(Allow(ReadAccess), AnyDN.Eq())),
+	)
+	if dups := acis.DuplicateACLNames(); len(dups) != 0 {
+		t.Errorf("%s failed: unexpected duplicates: %v", t.Name(), dups)
+		return
+	} else if err := acis.Valid(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	acis.Push(
+		ACI(`A`, PBR(Allow(WriteAccess), SelfDN.Eq())),
+		ACI(`A`, PBR(Allow(SearchAccess), SelfDN.Eq())),
+		ACI(`b`, PBR(Allow(WriteAccess), SelfDN.Eq())),
+	)
+	if dups := acis.DuplicateACLNames(); len(dups) != 2 || dups[0] != `A` || dups[1] != `B` {
+		t.Errorf("%s failed: unexpected duplicates: %v", t.Name(), dups)
+		return
+	} else if err := acis.Valid(); err == nil {
+		t.Errorf("%s failed: expected error, got nil", t.Name())
+		return
+	}
+}
+
+func ExampleInstruction_Conditions() {
+	ors := Or().Paren().Push(
+		UDN(`uid=jesse,ou=admin,dc=example,dc=com`).Eq(),
+		UDN(`uid=courtney,ou=admin,dc=example,dc=com`).Eq(),
+	)
+	nots := Not().Paren().Push(UAT(AT(`ninja`), AV(`FALSE`)).Eq())
+	brule := And().Paren().Push(ToDBetween(`1730`, `2400`), ors, nots)
+
+	var i Instruction
+	i.Set(`Limit people access to timeframe`,
+		TRs().Push(TDN(`uid=*,ou=People,dc=example,dc=com`).Eq()),
+		PBR(Allow(ReadAccess), brule),
+	)
+
+	for _, c := range i.Conditions() {
+		fmt.Printf("%s %d %s %s %s %t\n", c.Scope, c.Depth,
+			c.Keyword, c.Operator, c.Expression, c.Negated)
+	}
+	// Output:
+	// target 0 target = ldap:///uid=*,ou=People,dc=example,dc=com false
+	// bind 2 timeofday >= 1730 false
+	// bind 2 timeofday < 2400 false
+	// bind 2 userdn = ldap:///uid=jesse,ou=admin,dc=example,dc=com false
+	// bind 2 userdn = ldap:///uid=courtney,ou=admin,dc=example,dc=com false
+	// bind 2 userattr = ninja#FALSE true
+}
+
+func TestInstruction_Conditions(t *testing.T) {
+	var i Instruction
+	if conds := i.Conditions(); conds != nil {
+		t.Errorf("%s failed: expected nil, got %v", t.Name(), conds)
+		return
+	}
+
+	i.Set(`single`, PBR(Allow(ReadAccess), UDN(`uid=jesse,ou=People,dc=example,dc=com`).Eq()))
+	conds := i.Conditions()
+	if len(conds) != 1 {
+		t.Errorf("%s failed: want 1 condition, got %d", t.Name(), len(conds))
+		return
+	}
+
+	if c := conds[0]; c.Scope != `bind` || c.Depth != 0 || c.Keyword != BindUDN || c.Operator != Eq {
+		t.Errorf("%s failed: unexpected %T: %#v", t.Name(), c, c)
+		return
+	}
+}
+
 func ExampleInstruction_PBRs() {
 	t := TDN(`uid=*,ou=People,dc=example,dc=com`).Eq()
 	tgt := TRs().Push(t)
@@ -415,6 +953,158 @@ func ExampleInstruction_ACL() {
 	// Output: This is an access control label
 }
 
+func TestInstruction_ordering(t *testing.T) {
+	pbr := PBR(Allow(ReadAccess), Anyone().Eq())
+
+	// a target value bearing the header sequence is not misplaced
+	i := ACI(`Ordered`, TRs(Filter(`(description=(version 3.0; x))`).Eq()), pbr)
+	if err := i.Valid(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	// slices foreign to a TargetRules stack are refused
+	for idx, bogus := range []any{
+		`(version 3.0; acl "Bogus"; allow(all) userdn = "ldap:///all";)`,
+		Anyone().Eq(),
+	} {
+		i = ACI(`Misordered`, pbr)
+		i.instruction.TRs.cast().
+			SetPushPolicy(func(...any) error { return nil }).
+			Push(bogus)
+
+		if err := i.Valid(); err == nil {
+			t.Errorf("%s[%d] failed: expected ordering error, got nil", t.Name(), idx)
+			return
+		} else if got := i.String(); got != badACI {
+			t.Errorf("%s[%d] failed: want %s, got %s", t.Name(), idx, badACI, got)
+			return
+		}
+	}
+}
+
+func ExampleInstruction_SetHeader() {
+	i := ACI(`Old label`, PBR(Allow(ReadAccess), Anyone().Eq()))
+	if err := i.SetHeader(Header{Version: 3.0, ACL: `New label`}); err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("%s", i)
+	// Output: (version 3.0; acl "New label"; allow(read) userdn = "ldap:///anyone";)
+}
+
+func TestInstruction_Header(t *testing.T) {
+	var i Instruction
+	if h := i.Header(); h != (Header{}) {
+		t.Errorf("%s failed: expected zero %T, got %#v", t.Name(), h, h)
+		return
+	}
+
+	// bogus headers are refused without modifying the receiver
+	for idx, bogus := range []Header{
+		{Version: 2.0, ACL: `label`},
+		{Version: 3.0},
+		{},
+	} {
+		if err := i.SetHeader(bogus); err == nil {
+			t.Errorf("%s[%d] failed: expected error for %#v", t.Name(), idx, bogus)
+			return
+		} else if !i.IsZero() {
+			t.Errorf("%s[%d] failed: receiver modified by bogus %T", t.Name(), idx, bogus)
+			return
+		}
+	}
+
+	// a zero Version implies the Version constant
+	if err := i.SetHeader(Header{ACL: `First`}); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+	i.Set(`Ignored`, PBR(Allow(ReadAccess), Anyone().Eq()))
+
+	want := Header{Version: Version, ACL: `First`}
+	if h := i.Header(); h != want {
+		t.Errorf("%s failed:\nwant: %#v\ngot:  %#v", t.Name(), want, h)
+		return
+	} else if got := h.String(); got != `version 3.0; acl "First";` {
+		t.Errorf("%s failed: unexpected %T string: %s", t.Name(), h, got)
+		return
+	}
+
+	if err := i.SetHeader(Header{ACL: `Second`}); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if got := i.String(); !strings.Contains(got, `acl "Second";`) {
+		t.Errorf("%s failed: header change not reflected: %s", t.Name(), got)
+	}
+}
+
+func ExampleInstruction_Reset() {
+	var i Instruction
+	for _, name := range []string{`First`, `Second`} {
+		i.Reset()
+		i.Set(name, PBR(Allow(ReadAccess), Anyone().Eq()))
+		fmt.Println(i)
+	}
+	i.Reset()
+	fmt.Printf("Valid: %t", i.Valid() == nil)
+	// Output:
+	// (version 3.0; acl "First"; allow(read) userdn = "ldap:///anyone";)
+	// (version 3.0; acl "Second"; allow(read) userdn = "ldap:///anyone";)
+	// Valid: false
+}
+
+func TestInstruction_Reset(t *testing.T) {
+	i := ACI(`Reset me`,
+		TRs(TDN(`ou=People,dc=example,dc=com`).Eq()),
+		PBR(Allow(ReadAccess), Anyone().Eq()),
+	)
+
+	inner := i.instruction
+	i.Reset()
+
+	if i.IsZero() {
+		t.Errorf("%s failed: receiver zero following reset", t.Name())
+		return
+	} else if i.TRs().Len() != 0 || i.PBRs().Len() != 0 || i.ACL() != `` {
+		t.Errorf("%s failed: components not cleared: %#v", t.Name(), i.instruction)
+		return
+	} else if i.Valid() == nil || i.String() != badACI {
+		t.Errorf("%s failed: reset receiver should be invalid, got %s", t.Name(), i)
+		return
+	} else if i.instruction != inner {
+		t.Errorf("%s failed: receiver reallocated", t.Name())
+		return
+	}
+
+	// a new label is permitted
+	i.Set(`Reused`, TRs(TDN(`ou=Groups,dc=example,dc=com`).Eq()), PBR(Allow(SearchAccess), Anyone().Eq()))
+	want := `( target = "ldap:///ou=Groups,dc=example,dc=com" )(version 3.0; acl "Reused"; allow(search) userdn = "ldap:///anyone";)`
+	if got := i.String(); got != want {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), want, got)
+		return
+	}
+
+	// components pushed through the accessors must
+	// land within the receiver, whether fresh or reset.
+	for idx, j := range []Instruction{ACI(), i} {
+		j.Reset()
+		j.TRs().Push(TDN(`ou=People,dc=example,dc=com`).Eq())
+		j.PBRs().Push(PBR(Allow(ReadAccess), Anyone().Eq()))
+		if j.TRs().Len() != 1 || j.PBRs().Len() != 1 {
+			t.Errorf("%s[%d] failed: pushes lost (TRs=%d, PBRs=%d)",
+				t.Name(), idx, j.TRs().Len(), j.PBRs().Len())
+			return
+		}
+	}
+
+	var zero Instruction
+	zero.Reset()
+	if !zero.IsZero() {
+		t.Errorf("%s failed: nil receiver was modified", t.Name())
+	}
+}
+
 func ExampleInstruction_IsZero() {
 	var i Instruction
 	fmt.Printf("Zero: %t", i.IsZero())
@@ -514,3 +1204,478 @@ func ExampleInstructions_String() {
 	// ( target = "ldap:///uid=*,ou=People,dc=example,dc=com" )(version 3.0; acl "Limit people access to timeframe"; allow(read,search,compare) ( timeofday >= "1730" AND timeofday < "2400" );)
 	// ( targetfilter = "(&(objectClass=employee)(objectClass=engineering))" )( targetcontrol = "1.2.3.4" || "1.2.3.5" )( targetscope = "onelevel" )(version 3.0; acl "Allow read and write for anyone using greater than or equal 128 SSF - extra nesting"; allow(read,write) ( ( ( userdn = "ldap:///anyone" ) AND ( ssf >= "71" ) ) AND NOT ( dayofweek = "Wed" OR dayofweek = "Fri" ) ); deny(selfwrite,proxy) ( userdn = "ldap:///all" );)
 }
+
+func ExampleInstructions_Freeze() {
+	acis := ACIs(ACI(
+		`Anonymous read`,
+		TDN(`ou=People,dc=example,dc=com`).Eq(),
+		PBR(Allow(ReadAccess), Anyone().Eq()),
+	))
+	acis.Freeze()
+
+	// Pushes are refused while frozen.
+	acis.Push(ACI(
+		`Self write`,
+		TDN(`ou=People,dc=example,dc=com`).Eq(),
+		PBR(Allow(WriteAccess), Self().Eq()),
+	))
+
+	fmt.Printf("%t %d", acis.IsFrozen(), acis.Len())
+	// Output: true 1
+}
+
+func TestInstructions_Freeze(t *testing.T) {
+	var zero Instructions
+	_ = zero.Freeze()
+	_ = zero.Thaw()
+	if zero.IsFrozen() {
+		t.Errorf("%s failed: zero %T is frozen", t.Name(), zero)
+		return
+	}
+
+	acis := ACIs(ACI(
+		`Anonymous read`,
+		TDN(`ou=People,dc=example,dc=com`).Eq(),
+		PBR(Allow(ReadAccess), Anyone().Eq()),
+	))
+	want := acis.String()
+
+	if got := acis.Freeze().String(); got != want {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), want, got)
+		return
+	}
+
+	acis.Thaw().Push(ACI(
+		`Self write`,
+		TDN(`ou=People,dc=example,dc=com`).Eq(),
+		PBR(Allow(WriteAccess), Self().Eq()),
+	))
+
+	if acis.IsFrozen() || acis.Len() != 2 || acis.String() == want {
+		t.Errorf("%s failed: stale %T following thaw", t.Name(), acis)
+		return
+	}
+
+	acis.Freeze()
+	_ = acis.Pop()
+	if acis.Len() != 2 {
+		t.Errorf("%s failed: frozen %T permitted Pop", t.Name(), acis)
+		return
+	}
+}
+
+func benchmarkInstructions(n int) Instructions {
+	acis := ACIs()
+	for i := 0; i < n; i++ {
+		acis.Push(ACI(
+			sprintf("Instruction %d", i),
+			TDN(sprintf("uid=user%d,ou=People,dc=example,dc=com", i)).Eq(),
+			PBR(Allow(ReadAccess, SearchAccess), Anyone().Eq()),
+		))
+	}
+
+	return acis
+}
+
+func BenchmarkInstructions_String(b *testing.B) {
+	acis := benchmarkInstructions(100)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = acis.String()
+	}
+}
+
+func BenchmarkInstructions_StringFrozen(b *testing.B) {
+	acis := benchmarkInstructions(100).Freeze()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = acis.String()
+	}
+}
+
+func BenchmarkACI(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = ACI(
+			`Limit people access to timeframe`,
+			TRs(
+				TDN(`ou=People,dc=example,dc=com`).Eq(),
+				TAs(`cn`, `sn`, `givenName`).Eq(),
+			),
+			PBRs(
+				PBR(Allow(ReadAccess, SearchAccess), And(
+					Anyone().Eq(),
+					ToD(`0730`).Ge(),
+					ToD(`1615`).Lt(),
+				)),
+				PBR(Deny(WriteAccess), Not(UDN(`uid=jesse,ou=People,dc=example,dc=com`).Eq())),
+			),
+		)
+	}
+}
+
+func ExampleInstructions_ValidateAll() {
+	acis := ACIs(
+		ACI(
+			`Anonymous read`,
+			TDN(`ou=People,dc=example,dc=com`).Eq(),
+			PBR(Allow(ReadAccess, SearchAccess), Anyone().Eq()),
+		),
+		ACI(TDN(`ou=Groups,dc=example,dc=com`).Eq(), PBR(Allow(ReadAccess), Anyone().Eq())), // no label
+	)
+
+	for _, err := range acis.ValidateAll() {
+		fmt.Println(err)
+	}
+	// Output: aci.Instruction #1 is invalid: aci.Instruction has no name (ACL); set a string name value using aci.Instruction.Set
+}
+
+func TestInstructions_ValidateAllContext(t *testing.T) {
+	acis := benchmarkInstructions(3)
+	if errs := acis.ValidateAll(); len(errs) != 0 {
+		t.Errorf("%s failed: unexpected errors: %v", t.Name(), errs)
+		return
+	}
+
+	acis.Push(ACI(`No PBRs`, TDN(`ou=People,dc=example,dc=com`).Eq()))
+	if errs := acis.ValidateAll(); len(errs) != 1 {
+		t.Errorf("%s failed: want 1 error, got %d", t.Name(), len(errs))
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	errs := acis.ValidateAllContext(ctx)
+	if len(errs) != 1 || errs[0] != context.Canceled {
+		t.Errorf("%s failed: want %v, got %v", t.Name(), context.Canceled, errs)
+		return
+	}
+}
+
+func ExampleInstructions_WriteTo() {
+	acis := ACIs(
+		ACI(
+			`Anonymous read`,
+			TDN(`ou=People,dc=example,dc=com`).Eq(),
+			PBR(Allow(ReadAccess, SearchAccess), Anyone().Eq()),
+		),
+		ACI(
+			`Self write`,
+			TDN(`ou=People,dc=example,dc=com`).Eq(),
+			PBR(Allow(WriteAccess), Self().Eq()),
+		),
+	)
+
+	n, err := acis.WriteTo(os.Stdout)
+	fmt.Printf("wrote %d bytes (err: %v)", n, err)
+	// Output: ( target = "ldap:///ou=People,dc=example,dc=com" )(version 3.0; acl "Anonymous read"; allow(read,search) userdn = "ldap:///anyone";)
+	// ( target = "ldap:///ou=People,dc=example,dc=com" )(version 3.0; acl "Self write"; allow(write) userdn = "ldap:///self";)
+	// wrote 254 bytes (err: <nil>)
+}
+
+func TestInstructions_WriteTo(t *testing.T) {
+	acis := benchmarkInstructions(5)
+	want := acis.String() + string(rune(10))
+
+	for _, frozen := range []bool{false, true} {
+		if frozen {
+			acis.Freeze()
+		}
+
+		var buf bytes.Buffer
+		n, err := acis.WriteTo(&buf)
+		if err != nil {
+			t.Errorf("%s failed [frozen:%t]: %v", t.Name(), frozen, err)
+			return
+		} else if got := buf.String(); got != want || n != int64(len(want)) {
+			t.Errorf("%s failed [frozen:%t]:\nwant: %s\ngot:  %s", t.Name(), frozen, want, got)
+			return
+		}
+	}
+}
+
+func ExampleInstruction_PushPBRString() {
+	i := ACI(`Anonymous read`, TDN(`ou=People,dc=example,dc=com`).Eq())
+	err := i.PushPBRString(
+		`allow(read,search) userdn = "ldap:///anyone";`,
+		`deny(write) userdn = "ldap:///anyone";`,
+	)
+
+	fmt.Println(err)
+	fmt.Println(i)
+	// Output: <nil>
+	// ( target = "ldap:///ou=People,dc=example,dc=com" )(version 3.0; acl "Anonymous read"; allow(read,search) userdn = "ldap:///anyone"; deny(write) userdn = "ldap:///anyone";)
+}
+
+func TestInstruction_PushPBRString(t *testing.T) {
+	var i Instruction
+	err := i.PushPBRString(
+		`allow(read) userdn = "ldap:///anyone";`,
+		`allow(read) userdn = "ldap:///anyone";`,  // duplicate
+		`permit(read) userdn = "ldap:///anyone";`, // bogus disposition
+		`allow(write) userdn = "ldap:///self";`,
+	)
+
+	if err == nil {
+		t.Errorf("%s failed: expected error, got nil", t.Name())
+		return
+	} else if !contains(err.Error(), `#1`) || !contains(err.Error(), `#2`) || contains(err.Error(), `#3`) {
+		t.Errorf("%s failed: unexpected error: %v", t.Name(), err)
+		return
+	}
+
+	if l := i.PBRs().Len(); l != 2 {
+		t.Errorf("%s failed: want 2 %T, got %d", t.Name(), PermissionBindRule{}, l)
+		return
+	}
+}
+
+func ExampleInstructions_OverlappingTargets() {
+	acis := ACIs(
+		ACI(
+			`People read`,
+			TDN(`uid=*,ou=People,dc=example,dc=com`).Eq(),
+			PBR(Allow(ReadAccess), Anyone().Eq()),
+		),
+		ACI(
+			`Jesse write`,
+			TDN(`uid=jesse,ou=People,dc=example,dc=com`).Eq(),
+			PBR(Allow(WriteAccess), Self().Eq()),
+		),
+		ACI(
+			`Groups read`,
+			TDN(`cn=*,ou=Groups,dc=example,dc=com`).Eq(),
+			PBR(Allow(ReadAccess), Anyone().Eq()),
+		),
+	)
+
+	fmt.Println(acis.OverlappingTargets())
+	// Output: [[0 1]]
+}
+
+func TestDNPatternsOverlap(t *testing.T) {
+	for idx, test := range []struct {
+		a, b string
+		want bool
+	}{
+		{`uid=*,ou=People,dc=example,dc=com`, `uid=jesse,ou=People,dc=example,dc=com`, true},
+		{`ou=People,dc=example,dc=com`, `ldap:///uid=jesse,ou=People,dc=example,dc=com`, true},
+		{`uid=j*,ou=People,dc=example,dc=com`, `UID=Jesse,ou=people,dc=example,dc=com`, true},
+		{`uid=j*e,ou=People,dc=example,dc=com`, `uid=jess,ou=People,dc=example,dc=com`, false},
+		{`*,ou=People,dc=example,dc=com`, `cn=admins,ou=People,dc=example,dc=com`, true},
+		{`uid=*,ou=People,dc=example,dc=com`, `cn=admins,ou=Groups,dc=example,dc=com`, false},
+		{`cn=*,ou=People,dc=example,dc=com`, `uid=jesse,ou=People,dc=example,dc=com`, false},
+		{`dc=example,dc=com`, `dc=example,dc=net`, false},
+	} {
+		if got := dnPatternsOverlap(test.a, test.b); got != test.want {
+			t.Errorf("%s[%d] failed: want %t, got %t", t.Name(), idx, test.want, got)
+			return
+		}
+	}
+}
+
+func ExampleInstructions_Conflicts() {
+	acis := ACIs(
+		ACI(
+			`People read`,
+			TDN(`uid=*,ou=People,dc=example,dc=com`).Eq(),
+			PBR(Allow(ReadAccess, SearchAccess, CompareAccess), AllDN.Eq()),
+		),
+		ACI(
+			`Block searches`,
+			TDN(`uid=*,ou=People,dc=example,dc=com`).Eq(),
+			PBR(Deny(SearchAccess, WriteAccess), IP(`10.0.0.0/8`).Eq()),
+		),
+	)
+
+	for _, c := range acis.Conflicts() {
+		fmt.Printf("%d vs %d: %v\n", c.Allow, c.Deny, c.Rights)
+	}
+	// Output: 0 vs 1: [search]
+}
+
+func TestInstructions_Conflicts(t *testing.T) {
+	acis := ACIs(
+		ACI(`a`, TDN(`ou=People,dc=example,dc=com`).Eq(), PBR(Allow(AllAccess), AllDN.Eq())),
+		ACI(`b`, TDN(`LDAP:///OU=people,DC=example,DC=com`).Eq(), PBR(Deny(WriteAccess, DeleteAccess), AnyDN.Eq())),
+		ACI(`c`, TDN(`ou=Groups,dc=example,dc=com`).Eq(), PBR(Deny(AllAccess), AnyDN.Eq())),
+		ACI(`d`, TDN(`ou=Groups,dc=example,dc=com`).Eq(), PBR(Deny(ReadAccess), AnyDN.Eq())),
+		ACI(`e`, TRs().Push(TAs(`cn`).Eq()), PBR(Allow(ProxyAccess), AllDN.Eq())),
+		ACI(`f`, TRs().Push(TAs(`sn`).Eq()),
+			PBR(Deny(ProxyAccess, ReadAccess), AnyDN.Eq()),
+			PBR(Allow(ReadAccess), AllDN.Eq()),
+		),
+	)
+
+	got := acis.Conflicts()
+	want := []Conflict{
+		{Allow: 0, Deny: 1, Rights: []Right{WriteAccess, DeleteAccess}},
+		{Allow: 4, Deny: 5, Rights: []Right{ProxyAccess}},
+	}
+
+	if len(got) != len(want) {
+		t.Errorf("%s failed: want %d conflicts, got %d (%v)", t.Name(), len(want), len(got), got)
+		return
+	}
+
+	for idx := range want {
+		if got[idx].Allow != want[idx].Allow || got[idx].Deny != want[idx].Deny ||
+			fmt.Sprint(got[idx].Rights) != fmt.Sprint(want[idx].Rights) {
+			t.Errorf("%s[%d] failed: want %v, got %v", t.Name(), idx, want[idx], got[idx])
+			return
+		}
+	}
+
+	if c := ACIs().Conflicts(); len(c) != 0 {
+		t.Errorf("%s failed: unexpected conflicts for empty %T: %v", t.Name(), acis, c)
+	}
+}
+
+func ExampleInstruction_TargetDNs() {
+	i := ACI(`Move contractors`,
+		TRs().Push(
+			TTDN(`ou=Archive,dc=example,dc=com`).Eq(),
+			TFDNs(`ou=Contractors,dc=example,dc=com`, `ou=Temps,dc=example,dc=com`).Eq(),
+		),
+		PBR(Allow(ExportAccess, ImportAccess), GDN(`cn=Movers,ou=Groups,dc=example,dc=com`).Eq()),
+	)
+
+	fmt.Println(i.TargetDNs())
+	// Output: [ou=Archive,dc=example,dc=com ou=Contractors,dc=example,dc=com ou=Temps,dc=example,dc=com]
+}
+
+func TestInstruction_TargetDNs(t *testing.T) {
+	i := ACI(`Targets`,
+		TRs().Push(
+			TDNs(`uid=*,ou=People,dc=example,dc=com`, `ldap:///anyone`).Ne(),
+			TAs(`cn`).Eq(),
+		),
+		PBR(Allow(ReadAccess), AllDN.Eq()),
+	)
+
+	want := `[uid=*,ou=People,dc=example,dc=com ldap:///anyone]`
+	if got := fmt.Sprint(i.TargetDNs()); got != want {
+		t.Errorf("%s failed: want %s, got %s", t.Name(), want, got)
+		return
+	}
+
+	i = ACI(`No targets`, TRs().Push(TAs(`cn`).Eq()), PBR(Allow(ReadAccess), AllDN.Eq()))
+	if dns := i.TargetDNs(); dns != nil {
+		t.Errorf("%s failed: unexpected DNs: %v", t.Name(), dns)
+		return
+	}
+
+	var zero Instruction
+	if dns := zero.TargetDNs(); dns != nil {
+		t.Errorf("%s failed: unexpected DNs for zero %T: %v", t.Name(), zero, dns)
+	}
+}
+
+/*
+TestInstructions_Push_structured verifies that raw string values pushed into
+an Instructions instance are stored in fully parsed (structured) form, thus
+no string-backed Instruction is ever returned by Index.
+*/
+func TestInstructions_Push_structured(t *testing.T) {
+	raw := `( targetattr = "cn || sn" )(version 3.0; acl "Structured"; allow(read) userdn = "ldap:///anyone";)`
+	acis := ACIs(raw, `bogus`)
+	if acis.Len() != 1 {
+		t.Errorf("%s failed: want 1 %T, got %d", t.Name(), Instruction{}, acis.Len())
+		return
+	}
+
+	ins := acis.Index(0)
+	if ins.ACL() != `Structured` {
+		t.Errorf("%s failed: unexpected ACL: %s", t.Name(), ins.ACL())
+		return
+	} else if ins.TRs().Len() != 1 || ins.PBRs().Len() != 1 {
+		t.Errorf("%s failed: structured components missing: %d TRs, %d PBRs",
+			t.Name(), ins.TRs().Len(), ins.PBRs().Len())
+		return
+	} else if kw := ins.TRs().Index(0).Keyword(); kw != TargetAttr {
+		t.Errorf("%s failed: want %s, got %v", t.Name(), TargetAttr, kw)
+	}
+}
+
+/*
+TestInstruction_concurrent builds, renders, parses and validates many distinct
+Instruction instances concurrently. Run with -race to verify the absence of
+data races within shared package state.
+*/
+func TestInstruction_concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				i := ACI(WithPadding(g%2 == 0), fmt.Sprintf("Concurrent %d.%d", g, j),
+					TRs(
+						TDN(fmt.Sprintf("uid=*,ou=Group%d,dc=example,dc=com", g)).Eq(),
+						TAs(`sn`, `GIVENNAME`, `cn`).SetCase(AttributeCaseLower).Sort().Eq(),
+						Subtree.Eq(),
+					),
+					PBR(Allow(ReadAccess, SearchAccess), And(Anyone().Eq(), SSF(128).Ge(), Timeframe(ToD(`0800`), ToD(`1700`)))),
+				)
+
+				if err := i.Valid(); err != nil {
+					t.Errorf("%s[%d.%d] failed: %v", t.Name(), g, j, err)
+					return
+				}
+				_ = i.ValidStrict()
+				_ = i.Fingerprint()
+
+				var p Instruction
+				if err := p.Parse(i.String()); err != nil {
+					t.Errorf("%s[%d.%d] failed: %v", t.Name(), g, j, err)
+					return
+				} else if p.Fingerprint() != i.Fingerprint() {
+					t.Errorf("%s[%d.%d] failed:\nwant: %s\ngot:  %s", t.Name(), g, j, i, p)
+					return
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func TestInstructions_concurrentRender(t *testing.T) {
+	acis := buildTestInstructions()
+	want := acis.String()
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 64)
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if got := acis.String(); got != want {
+					errs <- got
+					return
+				}
+				for k := 0; k < acis.Len(); k++ {
+					i := acis.Index(k)
+					_ = i.Fingerprint()
+					_ = i.OperatorHistogram()
+					_ = i.ValidStrict()
+					_ = i.PBRs().String()
+					trs := i.TRs()
+					for l := 0; l < trs.Len(); l++ {
+						_ = trs.Index(l).StringBare()
+						_ = trs.Index(l).Describe()
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for got := range errs {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), want, got)
+		return
+	}
+}
@@ -0,0 +1,125 @@
+package aci
+
+/*
+ldif.go contains LDIF (RFC 2849) methods for use in ACI deployment.
+*/
+
+import (
+	"encoding/base64"
+)
+
+/*
+ldifValue contains a single (decoded) ACI statement read from LDIF content, or the error encountered while decoding it.
+*/
+type ldifValue struct {
+	string
+	err error
+}
+
+/*
+ldifFoldWidth is the maximum line length, in bytes, of LDIF output produced by this package, per the recommendation of RFC 2849.
+*/
+const ldifFoldWidth = 76
+
+/*
+LDIF returns an LDIF (RFC 2849) "changetype: modify" record which, when applied, shall modify the 'aci' attribute of the entry identified by dn using the string representation of the receiver as the attribute value.
+
+The op input value must be one (1) of "add", "replace" or "delete", and is case-insensitive. Lines which exceed seventy-six (76) bytes are folded. Values (including the DN) that are not "safe" per RFC 2849, such as those containing non-ASCII characters, are base64-encoded.
+
+A zero string is returned if the receiver is invalid, if dn is zero length, or if op is not recognized.
+*/
+func (r Instruction) LDIF(dn, op string) (ldif string) {
+	op = lc(op)
+	if r.Valid() != nil || len(dn) == 0 || !strInSlice(op, []string{`add`, `replace`, `delete`}) {
+		return
+	}
+
+	ldif = ldifLine(`dn`, dn) +
+		`changetype: modify` + "\n" +
+		op + `: aci` + "\n" +
+		ldifLine(`aci`, r.String()) +
+		`-` + "\n"
+
+	return
+}
+
+/*
+ldifLine returns a newline-terminated LDIF attribute-value line, which shall be base64-encoded and/or folded as needed.
+*/
+func ldifLine(attr, value string) string {
+	line := attr + `: ` + value
+	if !ldifSafeString(value) {
+		line = attr + `:: ` + base64.StdEncoding.EncodeToString([]byte(value))
+	}
+
+	return ldifFold(line)
+}
+
+/*
+ldifFold returns a newline-terminated copy of line, folded such that no line exceeds ldifFoldWidth bytes. Continuation lines begin with a single space.
+*/
+func ldifFold(line string) (folded string) {
+	width := ldifFoldWidth
+	for len(line) > width {
+		folded += line[:width] + "\n "
+		line = line[width:]
+		width = ldifFoldWidth - 1
+	}
+
+	return folded + line + "\n"
+}
+
+/*
+ldifSafeString returns a Boolean value indicative of whether x conforms to the SAFE-STRING production of RFC 2849, thus not requiring base64 encoding. A trailing space also warrants encoding, as it would otherwise be lost.
+*/
+func ldifSafeString(x string) bool {
+	if len(x) == 0 {
+		return true
+	}
+
+	switch x[0] {
+	case ' ', ':', '<':
+		return false
+	}
+
+	if x[len(x)-1] == ' ' {
+		return false
+	}
+
+	for i := 0; i < len(x); i++ {
+		switch c := x[i]; {
+		case c == 0, c == '\n', c == '\r', c > 127:
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+ldifUnfold returns a copy of raw in which all line endings have been normalized to newlines (ASCII #10), and all folded (continuation) lines have been joined to their predecessors, per RFC 2849.
+*/
+func ldifUnfold(raw string) string {
+	raw = repAll(raw, "\r\n", "\n")
+	return repAll(raw, "\n ", ``)
+}
+
+/*
+ldifACIValues returns slices of ldifValue, each containing one (1) ACI statement read from raw. Both `aci:` and `aci::` (base64) lines are honored, as are lines containing a bare instruction statement. All other lines are ignored.
+*/
+func ldifACIValues(raw string) (values []ldifValue) {
+	for _, line := range split(ldifUnfold(raw), "\n") {
+		line = trimS(line)
+		switch {
+		case len(line) > 5 && eq(line[:5], `aci::`):
+			dec, err := base64.StdEncoding.DecodeString(trimS(line[5:]))
+			values = append(values, ldifValue{string(dec), err})
+		case len(line) > 4 && eq(line[:4], `aci:`):
+			values = append(values, ldifValue{trimS(line[4:]), nil})
+		case hasPfx(line, `(`):
+			values = append(values, ldifValue{line, nil})
+		}
+	}
+
+	return
+}
@@ -0,0 +1,122 @@
+package aci
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+)
+
+func ExampleInstruction_LDIF() {
+	i := ACI(`Allow anonymous reads`,
+		TRs().Push(TAs(`cn`, `sn`, `givenName`, `mail`).Eq()),
+		PBR(Allow(ReadAccess, SearchAccess, CompareAccess), AnyDN.Eq()),
+	)
+
+	fmt.Print(i.LDIF(`ou=People,dc=example,dc=com`, `add`))
+	// Output:
+	// dn: ou=People,dc=example,dc=com
+	// changetype: modify
+	// add: aci
+	// aci: ( targetattr = "cn || sn || givenName || mail" )(version 3.0; acl "Allo
+	//  w anonymous reads"; allow(read,search,compare) userdn = "ldap:///anyone";)
+	// -
+}
+
+func TestInstruction_LDIF(t *testing.T) {
+	i := ACI(`Allow anonymous reads`, PBR(Allow(ReadAccess), AnyDN.Eq()))
+
+	for _, bogus := range [][2]string{
+		{`ou=People,dc=example,dc=com`, `modify`},
+		{``, `add`},
+	} {
+		if ldif := i.LDIF(bogus[0], bogus[1]); ldif != `` {
+			t.Errorf("%s failed: expected zero string for %v, got %s", t.Name(), bogus, ldif)
+			return
+		}
+	}
+
+	var zero Instruction
+	if ldif := zero.LDIF(`dc=example,dc=com`, `add`); ldif != `` {
+		t.Errorf("%s failed: expected zero string for zero %T, got %s", t.Name(), zero, ldif)
+		return
+	}
+
+	// non-ASCII values must be base64-encoded
+	i = ACI(`Zugriff für Gäste`, PBR(Allow(ReadAccess), AnyDN.Eq()))
+	want := `dn: dc=example,dc=com` + "\n" +
+		`changetype: modify` + "\n" +
+		`replace: aci` + "\n" +
+		ldifFold(`aci:: `+base64.StdEncoding.EncodeToString([]byte(i.String()))) +
+		`-` + "\n"
+	if got := i.LDIF(`dc=example,dc=com`, `REPLACE`); got != want {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), want, got)
+		return
+	}
+
+	for idx, line := range split(want, "\n") {
+		if len(line) > ldifFoldWidth {
+			t.Errorf("%s failed: line %d exceeds %d bytes", t.Name(), idx, ldifFoldWidth)
+			return
+		}
+	}
+}
+
+func ExampleParseInstructions() {
+	ldif := `dn: ou=People,dc=example,dc=com
+changetype: modify
+add: aci
+aci: ( targetattr = "cn || sn || givenName || mail" )(version 3.0; acl "Allo
+ w anonymous reads"; allow(read,search,compare) userdn = "ldap:///anyone";)
+-
+`
+	acis, err := ParseInstructions(ldif)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(acis.Index(0).ACL())
+	// Output: Allow anonymous reads
+}
+
+func TestParseInstructions(t *testing.T) {
+	a := ACI(`Allow anonymous reads`,
+		TRs().Push(TAs(`cn`, `sn`, `givenName`, `mail`).Eq()),
+		PBR(Allow(ReadAccess, SearchAccess, CompareAccess), AnyDN.Eq()),
+	)
+	b := ACI(`Zugriff für Gäste`, TRs().Push(TAs(`cn`).Eq()), PBR(Allow(ReadAccess), AnyDN.Eq()))
+	c := ACI(`Self writes`, TRs().Push(TAs(`mail`).Eq()), PBR(Allow(WriteAccess), SelfDN.Eq()))
+
+	raw := `# exported ACIs` + "\r\n" +
+		a.LDIF(`ou=People,dc=example,dc=com`, `add`) + "\n" +
+		b.LDIF(`dc=example,dc=com`, `add`) + "\n" +
+		c.String() + "\n"
+
+	acis, err := ParseInstructions(raw)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if acis.Len() != 3 {
+		t.Errorf("%s failed: want 3 %T, got %d", t.Name(), a, acis.Len())
+		return
+	}
+
+	for idx, want := range []Instruction{a, b, c} {
+		if got := acis.Index(idx).String(); got != want.String() {
+			t.Errorf("%s[%d] failed:\nwant: %s\ngot:  %s", t.Name(), idx, want, got)
+			return
+		}
+	}
+
+	// bad statements are reported, good ones are retained
+	raw = `aci:: !!notbase64!!` + "\n" +
+		`aci: (version 3.0; bogus` + "\n" +
+		c.String()
+	if acis, err = ParseInstructions(raw); err == nil {
+		t.Errorf("%s failed: expected error, got nil", t.Name())
+		return
+	} else if acis.Len() != 1 {
+		t.Errorf("%s failed: want 1 %T, got %d", t.Name(), c, acis.Len())
+		return
+	}
+}
@@ -117,6 +117,15 @@ func (r Inheritance) BRM() BindRuleMethods {
 	})
 }
 
+/*
+Rule returns a [BindRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [BindRule] is returned if op is not permitted for use with the receiver.
+
+See also the [Inheritance.BRM] method.
+*/
+func (r Inheritance) Rule(op any) BindRule {
+	return r.BRM().rule(op)
+}
+
 /*
 Eq initializes and returns a new [BindRule] instance configured to express the evaluation of the receiver value as Equal-To the [BindUAT] or [BindGAT] [BindKeyword] contexts.
 */
@@ -249,6 +258,47 @@ func (r Inheritance) Keyword() (kw Keyword) {
 	return
 }
 
+/*
+Levels returns the [Level] instances enabled within the receiver instance in ascending order. For example, `parent[0,1,2].manager#USERDN` would return [Level0], [Level1] and [Level2]. A nil slice is returned if the receiver is invalid, or if no levels have been enabled.
+*/
+func (r Inheritance) Levels() (lvls []Level) {
+	if err := r.Valid(); err != nil {
+		return
+	}
+
+	for i := 0; i < levelBitIter; i++ {
+		if lvl := Level(1 << i); r.Positive(lvl) {
+			lvls = append(lvls, lvl)
+		}
+	}
+
+	return
+}
+
+/*
+BaseAttribute returns the [AttributeType] upon which the receiver instance is based. For example, `parent[0,1,2].manager#USERDN` would return `manager`. A bogus [AttributeType] is returned if the receiver is invalid.
+*/
+func (r Inheritance) BaseAttribute() (at AttributeType) {
+	if err := r.Valid(); err == nil && r.inheritance.atbtv != nil {
+		at, _ = r.inheritance.atbtv[0].(AttributeType)
+	}
+
+	return
+}
+
+/*
+BindType returns the string representation of the [BindType] upon which the receiver instance is based. For example, `parent[0,1,2].manager#USERDN` would return `USERDN`. A zero string is returned if the receiver is invalid, or if it bears an [AttributeValue] in place of a [BindType].
+*/
+func (r Inheritance) BindType() (bt string) {
+	if err := r.Valid(); err == nil && r.inheritance.atbtv != nil {
+		if t, ok := r.inheritance.atbtv[1].(BindType); ok {
+			bt = t.String()
+		}
+	}
+
+	return
+}
+
 /*
 String is a stringer method that returns the string name value for receiver instance.
 
@@ -310,3 +310,28 @@ func TestInheritance_codecov(t *testing.T) {
 	_ = inh.Positive(Level(^uint16(0)))
 	_ = inh.Positive(3.14159)
 }
+
+func ExampleInheritance_BaseAttribute() {
+	inh := Inherit(UAT(AT(`manager`), USERDN), 0, 1, 2)
+	fmt.Printf("%s %s %s", inh.BaseAttribute(), inh.BindType(), inh.Levels())
+	// Output: manager USERDN [0 1 2]
+}
+
+func TestInheritance_accessors(t *testing.T) {
+	inh := Inherit(UAT(AT(`owner`), AV(`uid=frank,ou=People,dc=example,dc=com`)), 3, 7)
+	if at := inh.BaseAttribute(); at.String() != `owner` {
+		t.Errorf("%s failed: want owner, got %s", t.Name(), at)
+		return
+	} else if bt := inh.BindType(); bt != `` {
+		t.Errorf("%s failed: expected zero bind type for value-based instance, got %s", t.Name(), bt)
+		return
+	} else if lvls := inh.Levels(); len(lvls) != 2 || lvls[0] != Level3 || lvls[1] != Level7 {
+		t.Errorf("%s failed: unexpected levels %v", t.Name(), lvls)
+		return
+	}
+
+	var zero Inheritance
+	if !zero.BaseAttribute().IsZero() || zero.BindType() != `` || zero.Levels() != nil {
+		t.Errorf("%s failed: expected zero values for zero %T", t.Name(), zero)
+	}
+}
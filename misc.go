@@ -40,6 +40,24 @@ This constant may be fed to the [AttributeFilterOperations.SetDelimiter] method.
 */
 const AttributeFilterOperationsSemiDelim = 1
 
+/*
+MultivalSymbolDelim invokes the default delimitation scheme offered by this package for use within multi-valued instances of the [AttributeTypes] and [ObjectIdentifiers] types, which is the symbolic OR operator (`||`).
+
+	Example: targetattr = "cn || sn || givenName"
+
+This constant may be fed to the SetDelimiter method that is extended through eligible types.
+*/
+const MultivalSymbolDelim = 0
+
+/*
+MultivalCommaDelim invokes the alternative delimitation scheme offered by this package for use within multi-valued instances of the [AttributeTypes] and [ObjectIdentifiers] types, which is the comma (ASCII #44). Certain directory products expect this form of delimitation for certain [TargetKeyword] contexts.
+
+	Example: targetattr = "cn,sn,givenName"
+
+This constant may be fed to the SetDelimiter method that is extended through eligible types.
+*/
+const MultivalCommaDelim = 1
+
 /*
 MultivalOuterQuotes represents the alternate quotation style used by this package. In cases where a multi-valued [BindRule] or [TargetRule] expression involving distinguished names, ASN.1 Object Identifiers (in dot notation) and LDAP Attribute Type names is being created, this constant will enforce only outer-most double-quotation of the whole sequence of values, including the delimiters.
 
@@ -63,7 +81,7 @@ RulePadding is a global variable that will be applies to ALL [TargetRule] and [B
 
 Padding is enabled by default, and can be disabled here globally, or overridden for individual [TargetRule]/[BindRule] instances as needed.
 
-Note that altering this value will not impact instances that were already created; this only impacts the creation of new instances.
+Note that altering this value will not impact instances that were already created; this only impacts the creation of new instances. This value is read without synchronization, thus it should not be altered while other goroutines are building instances; see [WithPadding] for a per-call alternative.
 */
 var RulePadding bool = true
 
@@ -72,41 +90,173 @@ StackPadding is a global variable that will be applies to ALL [stackage.Stack] i
 
 Padding is enabled by default, and can be disabled here globally, or overridden for individual [stackage.Stack] instances as needed.
 
-Note that altering this value will not impact instances that were already created; this only impacts the creation of new instances.
+Note that altering this value will not impact instances that were already created; this only impacts the creation of new instances. This value is read without synchronization, thus it should not be altered while other goroutines are building instances; see [WithPadding] for a per-call alternative.
 */
 var StackPadding bool = true
 
+/*
+PaddingOption is a construction option, produced by the [WithPadding] function, which overrides the [RulePadding] and [StackPadding] global variables for a single call of the [TRs], [PBRs] or [ACI] functions. This allows padding to be controlled without altering global state, which may be unsafe in the presence of concurrent builders.
+*/
+type PaddingOption struct {
+	pad bool
+}
+
+/*
+WithPadding returns a [PaddingOption] that, when submitted to [TRs], [PBRs] or [ACI] alongside other input values, enables or disables padding per pad. The option is applied to the return instance and to copies of every [TargetRule], [BindRule] and [BindRules] instance submitted within the same call, at any depth, for example:
+
+	TRs(WithPadding(false), TAs(`cn`).Eq()) // (targetattr="cn")
+
+Submitted instances are not modified, thus a single instance may be shared between builders of differing padding. Instances pushed after construction are not affected. If more than one [PaddingOption] is submitted, the last one wins.
+*/
+func WithPadding(pad bool) PaddingOption {
+	return PaddingOption{pad}
+}
+
+/*
+paddingOption returns x less any [PaddingOption] instances, alongside the last [PaddingOption] found, if any. If a [PaddingOption] is found, the rules within the return slice are copies (see copyRules), as they are subject to applyPadding.
+*/
+func paddingOption(x []any) (rest []any, opt *PaddingOption) {
+	for i := 0; i < len(x); i++ {
+		if po, ok := x[i].(PaddingOption); ok {
+			opt = &po
+		} else {
+			rest = append(rest, x[i])
+		}
+	}
+
+	if opt != nil {
+		rest = copyRules(rest)
+	}
+
+	return
+}
+
+/*
+copyRules returns x with each [TargetRule], [BindRule], [BindRules], [PermissionBindRule] and [PermissionBindRules] instance replaced by a copy (see copyRule), such that the caller's instances are not altered by applyPadding. All other values are returned as-is.
+*/
+func copyRules(x []any) []any {
+	c := make([]any, len(x))
+	for i := 0; i < len(x); i++ {
+		c[i] = copyRule(x[i])
+	}
+
+	return c
+}
+
+/*
+copyRule returns a copy of x, descending into collections at any depth. Rule conditions are copied using copyCondition, thus expression values are shared. Values of any other type, as well as zero instances, are returned as-is.
+*/
+func copyRule(x any) any {
+	switch tv := x.(type) {
+	case TargetRule:
+		if !tv.IsZero() {
+			return TargetRule(copyCondition(tv.cast()))
+		}
+	case BindRule:
+		if !tv.IsZero() {
+			return BindRule(copyCondition(tv.cast()))
+		}
+	case BindRules:
+		if n, ok := newBindRulesLike(tv); ok {
+			for i := 0; i < tv.Len(); i++ {
+				n.Push(copyRule(tv.Index(i)))
+			}
+			return n.Paren(tv.IsParen()).NoPadding(!tv.cast().IsPadded())
+		}
+	case PermissionBindRule:
+		if !tv.IsZero() {
+			if b, ok := copyRule(tv.BindRules()).(BindContext); ok {
+				return PBR(tv.Permission(), b)
+			}
+		}
+	case PermissionBindRules:
+		c := PBRs()
+		for i := 0; i < tv.Len(); i++ {
+			c.Push(copyRule(tv.Index(i)))
+		}
+		return c
+	case TargetRules:
+		c := TRs()
+		for i := 0; i < tv.Len(); i++ {
+			c.Push(copyRule(tv.Index(i)))
+		}
+		return c
+	}
+
+	return x
+}
+
+/*
+applyPadding enables or disables padding per pad for x and, where x is a collection, for all of its members at any depth. x should be an instance created by the caller of this function, or bear copies of submitted instances (see copyRules).
+*/
+func applyPadding(x any, pad bool) {
+	switch tv := x.(type) {
+	case TargetRule:
+		tv.NoPadding(!pad)
+	case TargetRules:
+		// mirror TRs; the NoPadding method of
+		// TargetRules governs the delimiter.
+		tv.cast().NoPadding(!pad)
+		for i := 0; i < tv.Len(); i++ {
+			applyPadding(tv.Index(i), pad)
+		}
+	case BindRule:
+		tv.NoPadding(!pad)
+	case BindRules:
+		tv.NoPadding(!pad)
+		for i := 0; i < tv.Len(); i++ {
+			applyPadding(tv.Index(i), pad)
+		}
+	case PermissionBindRule:
+		if !tv.IsZero() {
+			applyPadding(tv.BindRules(), pad)
+		}
+	case PermissionBindRules:
+		for i := 0; i < tv.Len(); i++ {
+			applyPadding(tv.Index(i), pad)
+		}
+	case Instruction:
+		if !tv.IsZero() {
+			applyPadding(tv.TRs(), pad)
+			applyPadding(tv.PBRs(), pad)
+		}
+	}
+}
+
 /*
 frequently-accessed import function aliases.
 */
 var (
-	lc       func(string) string                 = strings.ToLower
-	uc       func(string) string                 = strings.ToUpper
-	eq       func(string, string) bool           = strings.EqualFold
-	ctstr    func(string, string) int            = strings.Count
-	idxf     func(string, func(rune) bool) int   = strings.IndexFunc
-	idxr     func(string, rune) int              = strings.IndexRune
-	idxs     func(string, string) int            = strings.Index
-	hasPfx   func(string, string) bool           = strings.HasPrefix
-	hasSfx   func(string, string) bool           = strings.HasSuffix
-	repAll   func(string, string, string) string = strings.ReplaceAll
-	contains func(string, string) bool           = strings.Contains
-	split    func(string, string) []string       = strings.Split
-	trimS    func(string) string                 = strings.TrimSpace
-	trimPfx  func(string, string) string         = strings.TrimPrefix
-	join     func([]string, string) string       = strings.Join
-	printf   func(string, ...any) (int, error)   = fmt.Printf
-	sprintf  func(string, ...any) string         = fmt.Sprintf
-	itoa     func(int) string                    = strconv.Itoa
-	atoi     func(string) (int, error)           = strconv.Atoi
-	isDigit  func(rune) bool                     = unicode.IsDigit
-	isLetter func(rune) bool                     = unicode.IsLetter
-	isLower  func(rune) bool                     = unicode.IsLower
-	isUpper  func(rune) bool                     = unicode.IsUpper
-	uint16g  func([]byte) uint16                 = binary.BigEndian.Uint16
-	uint16p  func([]byte, uint16)                = binary.BigEndian.PutUint16
-	valOf    func(x any) reflect.Value           = reflect.ValueOf
-	typOf    func(x any) reflect.Type            = reflect.TypeOf
+	lc          func(string) string                 = strings.ToLower
+	uc          func(string) string                 = strings.ToUpper
+	eq          func(string, string) bool           = strings.EqualFold
+	ctstr       func(string, string) int            = strings.Count
+	idxf        func(string, func(rune) bool) int   = strings.IndexFunc
+	idxr        func(string, rune) int              = strings.IndexRune
+	idxs        func(string, string) int            = strings.Index
+	hasPfx      func(string, string) bool           = strings.HasPrefix
+	hasSfx      func(string, string) bool           = strings.HasSuffix
+	repAll      func(string, string, string) string = strings.ReplaceAll
+	contains    func(string, string) bool           = strings.Contains
+	containsAny func(string, string) bool           = strings.ContainsAny
+	split       func(string, string) []string       = strings.Split
+	trimS       func(string) string                 = strings.TrimSpace
+	trimPfx     func(string, string) string         = strings.TrimPrefix
+	trimR       func(string, string) string         = strings.TrimRight
+	join        func([]string, string) string       = strings.Join
+	rept        func(string, int) string            = strings.Repeat
+	printf      func(string, ...any) (int, error)   = fmt.Printf
+	sprintf     func(string, ...any) string         = fmt.Sprintf
+	itoa        func(int) string                    = strconv.Itoa
+	atoi        func(string) (int, error)           = strconv.Atoi
+	isDigit     func(rune) bool                     = unicode.IsDigit
+	isLetter    func(rune) bool                     = unicode.IsLetter
+	isLower     func(rune) bool                     = unicode.IsLower
+	isUpper     func(rune) bool                     = unicode.IsUpper
+	uint16g     func([]byte) uint16                 = binary.BigEndian.Uint16
+	uint16p     func([]byte, uint16)                = binary.BigEndian.PutUint16
+	valOf       func(x any) reflect.Value           = reflect.ValueOf
+	typOf       func(x any) reflect.Type            = reflect.TypeOf
 )
 
 /*
@@ -294,9 +444,12 @@ func hashInstance(x any) (s string, err error) {
 }
 
 /*
-getStringFunc uses reflect to obtain and return a given
-type instance's String method, if present. If not, nil
-is returned.
+getStringFunc obtains and returns a given type instance's
+String method, if present. If not, nil is returned.
+
+Type assertion against the [fmt.Stringer] interface is
+attempted first, as this is far cheaper than reflection.
+The use of reflect is only a fallback measure.
 */
 func getStringFunc(x any) (meth func() string) {
 	if x == nil {
@@ -304,6 +457,10 @@ func getStringFunc(x any) (meth func() string) {
 	}
 
 	if v := valOf(x); !v.IsZero() {
+		if str, ok := x.(fmt.Stringer); ok {
+			meth = str.String
+			return
+		}
 
 		method := v.MethodByName(`String`)
 		if method.Kind() == reflect.Invalid {
@@ -419,8 +576,7 @@ func condenseWHSP(b string) (a string) {
 	b = repAll(b, string(rune(10)), string(rune(32)))
 
 	var last bool
-	for i := 0; i < len(b); i++ {
-		c := rune(b[i])
+	for _, c := range b {
 		switch c {
 		// match space (32) or tab (9)
 		case rune(9), rune(10), rune(32):
@@ -1,7 +1,11 @@
 package aci
 
 import (
+	"fmt"
+	"sync"
 	"testing"
+
+	"github.com/JesseCoretta/go-stackage"
 )
 
 var copMap map[int]string = map[int]string{
@@ -217,6 +221,16 @@ func TestOperator_codecov(t *testing.T) {
 		return
 	}
 
+	// operators carried by parsed rules are honored,
+	// while arbitrary Stringers are not.
+	if !keywordAllowsComparisonOperator(Target, stackage.ComparisonOperator(Ne)) {
+		t.Errorf("%s failed; resolution error: stackage operator refused", t.Name())
+		return
+	} else if keywordAllowsComparisonOperator(Target, Target) {
+		t.Errorf("%s failed; resolution error: illegal type permitted", t.Name())
+		return
+	}
+
 	_ = unquote(`"quoted_string"`)
 
 	for i := 0; i < len(copMap); i++ {
@@ -266,3 +280,129 @@ func TestOperator_codecov(t *testing.T) {
 		}
 	}
 }
+
+func TestIsZeroLen_consistency(t *testing.T) {
+	type zeroLener interface {
+		IsZero() bool
+		Len() int
+	}
+
+	for idx, tc := range []struct {
+		zero, set zeroLener
+	}{
+		{AttributeType{}, AT(`cn`)},
+		{ObjectIdentifier{}, Ctrl(`1.3.6.1.4.1.56521.999.5`)},
+		{SecurityStrengthFactor{}, SSF(128)},
+		{TimeOfDay{}, ToD(`1700`)},
+		{DayOfWeek{}, DoW(`Mon`)},
+		{AuthenticationMethod(0), SASL},
+		{AuthenticationMethod(99), Simple},
+		{SearchScope(0), Subtree},
+		{Inheritance{}, Inherit(UAT(`manager`, USERDN), 0, 1)},
+	} {
+		if !tc.zero.IsZero() || tc.zero.Len() != 0 {
+			t.Errorf("%s[%d] failed: %T zero value not reported as such", t.Name(), idx, tc.zero)
+			return
+		} else if tc.set.IsZero() || tc.set.Len() == 0 {
+			t.Errorf("%s[%d] failed: %T set value reported as zero", t.Name(), idx, tc.set)
+			return
+		}
+	}
+}
+
+func ExampleWithPadding() {
+	i := ACI(WithPadding(false), `Compact`,
+		TRs(TAs(`cn`).Eq()),
+		PBR(Allow(ReadAccess), And(Anyone().Eq(), SSF(128).Ge()).Paren()),
+	)
+
+	fmt.Printf("%s", i)
+	// Output: (targetattr="cn")(version 3.0; acl "Compact"; allow(read) (userdn="ldap:///anyone" AND ssf>="128");)
+}
+
+func TestWithPadding(t *testing.T) {
+	build := func(x ...any) Instruction {
+		return ACI(append(x, `Padding`,
+			TRs(TDN(`ou=People,dc=example,dc=com`).Eq(), TAs(`cn`).Eq()),
+			PBR(Allow(ReadAccess), Or(Self().Eq(), And(SSF(128).Ge(), AllDN.Eq()).Paren())),
+		)...)
+	}
+
+	// build a reference instance by way of the globals
+	defer func(r, s bool) { RulePadding, StackPadding = r, s }(RulePadding, StackPadding)
+	RulePadding, StackPadding = false, false
+	want := build().String()
+	RulePadding, StackPadding = true, true
+	padded := build().String()
+
+	if got := build(WithPadding(false)).String(); got != want {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), want, got)
+		return
+	} else if got = build(WithPadding(true)).String(); got != padded {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), padded, got)
+		return
+	} else if got = build(WithPadding(true), WithPadding(false)).String(); got != want {
+		t.Errorf("%s failed: last option did not win:\nwant: %s\ngot:  %s", t.Name(), want, got)
+		return
+	}
+
+	// globals must remain untouched
+	if !RulePadding || !StackPadding {
+		t.Errorf("%s failed: global padding altered", t.Name())
+		return
+	}
+
+	trs := TRs(WithPadding(false), TAs(`cn`).Eq())
+	if got := trs.String(); got != `(targetattr="cn")` {
+		t.Errorf("%s failed: want %s, got %s", t.Name(), `(targetattr="cn")`, got)
+		return
+	}
+
+	pbrs := PBRs(WithPadding(false), PBR(Allow(ReadAccess), Self().Eq()))
+	if got := pbrs.String(); got != `allow(read) userdn="ldap:///self";` {
+		t.Errorf("%s failed: want %s, got %s", t.Name(), `allow(read) userdn="ldap:///self";`, got)
+		return
+	}
+
+	// submitted instances are not modified
+	tr := TAs(`cn`).Eq()
+	br := And(Self().Eq(), SSF(128).Ge()).Paren()
+	tro, bro := tr.String(), br.String()
+	_ = TRs(WithPadding(false), tr)
+	_ = ACI(WithPadding(false), `Shared`, TRs(tr), PBR(Allow(ReadAccess), br))
+	if tr.String() != tro || br.String() != bro {
+		t.Errorf("%s failed: submitted instances were modified:\n%s\n%s", t.Name(), tr, br)
+	}
+}
+
+func TestWithPadding_concurrent(t *testing.T) {
+	tr := TAs(`cn`, `sn`).Eq()
+	br := And(Self().Eq(), SSF(128).Ge()).Paren()
+	want := map[bool]string{
+		true:  `( targetattr = "cn || sn" )(version 3.0; acl "Shared"; allow(read) ( userdn = "ldap:///self" AND ssf >= "128" );)`,
+		false: `(targetattr="cn || sn")(version 3.0; acl "Shared"; allow(read) (userdn="ldap:///self" AND ssf>="128");)`,
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 64)
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(pad bool) {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				i := ACI(WithPadding(pad), `Shared`, TRs(WithPadding(pad), tr), PBR(Allow(ReadAccess), br))
+				if got := i.String(); got != want[pad] {
+					errs <- got
+					return
+				}
+			}
+		}(g%2 == 0)
+	}
+	wg.Wait()
+	close(errs)
+
+	for got := range errs {
+		t.Errorf("%s failed: unexpected output: %s", t.Name(), got)
+		return
+	}
+}
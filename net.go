@@ -4,6 +4,10 @@ package aci
 net.go contains types, methods and constants that relate to the use of IP addresses and DNS names within Bind Rules.
 */
 
+import (
+	"net"
+)
+
 /*
 IPAddr embeds slices of address values, allowing simple composition of flexible IP-based [BindRule] instances.
 */
@@ -85,6 +89,15 @@ func (r IPAddr) BRM() BindRuleMethods {
 	})
 }
 
+/*
+Rule returns a [BindRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [BindRule] is returned if op is not permitted for use with the receiver.
+
+See also the [IPAddr.BRM] method.
+*/
+func (r IPAddr) Rule(op any) BindRule {
+	return r.BRM().rule(op)
+}
+
 /*
 Compare returns a Boolean value indicative of a SHA-1 comparison between the receiver (r) and input value x.
 */
@@ -247,6 +260,112 @@ func (r IPAddr) String() string {
 	return sprintf("%s", join(str, `,`))
 }
 
+/*
+IsIPv4 returns a Boolean value indicative of whether the receiver contains one (1) or more addresses, all of which belong to the IPv4 address family.
+*/
+func (r IPAddr) IsIPv4() bool {
+	return r.isFamily(false)
+}
+
+/*
+IsIPv6 returns a Boolean value indicative of whether the receiver contains one (1) or more addresses, all of which belong to the IPv6 address family.
+*/
+func (r IPAddr) IsIPv6() bool {
+	return r.isFamily(true)
+}
+
+/*
+isFamily is a private method called by IPAddr.IsIPv4 and IPAddr.IsIPv6.
+*/
+func (r IPAddr) isFamily(v6 bool) bool {
+	if r.Len() == 0 {
+		return false
+	}
+
+	for i := 0; i < r.Len(); i++ {
+		if (*r.ipAddrs)[i].isV6() != v6 {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+Networks returns slices of *[net.IPNet], each of which represents a single address value within the receiver. The following address forms are supported:
+
+  - CIDR notation (e.g.: `192.168.0.0/16` or `fe80::/10`)
+  - Individual addresses (e.g.: `192.168.1.1` or `::1`), which result in a full host mask
+  - IPv4 wildcard addresses (e.g.: `192.168.*`), in which each wildcarded octet is excluded from the mask
+
+Address values that cannot be parsed into one (1) of the above forms are silently omitted from the return value.
+*/
+func (r IPAddr) Networks() (nets []*net.IPNet) {
+	for i := 0; i < r.Len(); i++ {
+		if n := (*r.ipAddrs)[i].network(); n != nil {
+			nets = append(nets, n)
+		}
+	}
+
+	return
+}
+
+/*
+isV6 returns a Boolean value indicative of whether the receiver is an IPv6 address value.
+*/
+func (r ipAddr) isV6() bool {
+	return contains(string(r), `:`)
+}
+
+/*
+network is a private method called by IPAddr.Networks. A nil return indicates the receiver could not be parsed.
+*/
+func (r ipAddr) network() (n *net.IPNet) {
+	addr := string(r)
+	if contains(addr, `/`) {
+		_, n, _ = net.ParseCIDR(addr)
+	} else if contains(addr, `*`) {
+		n = wildcardV4Network(addr)
+	} else if ip := net.ParseIP(addr); ip != nil {
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil && !r.isV6() {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		n = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+	}
+
+	return
+}
+
+/*
+wildcardV4Network returns an instance of *[net.IPNet] based upon the input IPv4 wildcard address (e.g.: `192.168.*`). A nil return indicates the input could not be parsed.
+*/
+func wildcardV4Network(addr string) *net.IPNet {
+	octets := split(addr, `.`)
+	if len(octets) > net.IPv4len {
+		return nil
+	}
+
+	ip := make(net.IP, net.IPv4len)
+	var fixed int
+	for ; fixed < len(octets) && octets[fixed] != `*`; fixed++ {
+		o, err := atoi(octets[fixed])
+		if err != nil || o < 0 || o > 255 {
+			return nil
+		}
+		ip[fixed] = byte(o)
+	}
+
+	// only trailing wildcards are supported
+	for i := fixed; i < len(octets); i++ {
+		if octets[i] != `*` {
+			return nil
+		}
+	}
+
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(8*fixed, 8*net.IPv4len)}
+}
+
 //////////////////////////////////////////////////////////////////////////////////
 // Begin DNS/FQDN
 //////////////////////////////////////////////////////////////////////////////////
@@ -440,6 +559,15 @@ func (r FQDN) BRM() BindRuleMethods {
 	})
 }
 
+/*
+Rule returns a [BindRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [BindRule] is returned if op is not permitted for use with the receiver.
+
+See also the [FQDN.BRM] method.
+*/
+func (r FQDN) Rule(op any) BindRule {
+	return r.BRM().rule(op)
+}
+
 /*
 IsZero returns a Boolean value indicative of whether the receiver is nil, or unset.
 */
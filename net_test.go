@@ -384,3 +384,44 @@ func ExampleIPAddr_BRM() {
 	fmt.Printf("%T allows Eq: %t", address, cops.Contains(`=`))
 	// Output: aci.IPAddr allows Eq: true
 }
+
+func ExampleIPAddr_IsIPv4() {
+	fmt.Println(IP(`192.168.0.0/16`, `10.*`).IsIPv4())
+	fmt.Println(IP(`192.168.0.0/16`, `fe80::/10`).IsIPv4())
+	// Output: true
+	// false
+}
+
+func ExampleIPAddr_IsIPv6() {
+	fmt.Println(IP(`fe80::/10`, `::1`).IsIPv6())
+	// Output: true
+}
+
+func ExampleIPAddr_Networks() {
+	for _, n := range IP(`192.168.0.0/16`, `10.1.*`, `::1`, `192.168.1.5`).Networks() {
+		fmt.Println(n)
+	}
+	// Output: 192.168.0.0/16
+	// 10.1.0.0/16
+	// ::1/128
+	// 192.168.1.5/32
+}
+
+func TestIPAddr_Networks(t *testing.T) {
+	var zero IPAddr
+	if zero.IsIPv4() || zero.IsIPv6() || len(zero.Networks()) != 0 {
+		t.Errorf("%s failed: unexpected results for zero %T", t.Name(), zero)
+		return
+	}
+
+	bogus := IP(`10.*.1.*`, `1.2.3.4.5.*`, `300.*`, `192.168.0.0/33`)
+	if nets := bogus.Networks(); len(nets) != 0 {
+		t.Errorf("%s failed: unexpected networks parsed: %v", t.Name(), nets)
+		return
+	}
+
+	if n := IP(`*`, `10.0.0.1`).Networks(); len(n) != 1 {
+		t.Errorf("%s failed: want 1 network, got %d", t.Name(), len(n))
+		return
+	}
+}
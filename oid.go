@@ -111,6 +111,15 @@ func (r ObjectIdentifier) TRM() TargetRuleMethods {
 	})
 }
 
+/*
+Rule returns a [TargetRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [TargetRule] is returned if op is not permitted for use with the receiver.
+
+See also the [ObjectIdentifier.TRM] method.
+*/
+func (r ObjectIdentifier) Rule(op any) TargetRule {
+	return r.TRM().rule(op)
+}
+
 /*
 IsZero wraps [objectid.DotNotation.IsZero] method.
 */
@@ -256,6 +265,15 @@ func (r ObjectIdentifiers) TRM() TargetRuleMethods {
 	})
 }
 
+/*
+Rule returns a [TargetRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [TargetRule] is returned if op is not permitted for use with the receiver.
+
+See also the [ObjectIdentifiers.TRM] method.
+*/
+func (r ObjectIdentifiers) Rule(op any) TargetRule {
+	return r.TRM().rule(op)
+}
+
 /*
 IsZero wraps the [stackage.Stack.IsZero] method.
 */
@@ -382,6 +400,94 @@ func (r ObjectIdentifiers) contains(x any) bool {
 	return false
 }
 
+/*
+Sort reorders the receiver's [ObjectIdentifier] instances in place, by ascending numeric arc order (e.g.: `1.3.6.1.4.1.2` precedes `1.3.6.1.4.1.10`), and returns the receiver. This produces a canonical multi-valued expression, useful when comparing against server output.
+*/
+func (r ObjectIdentifiers) Sort() ObjectIdentifiers {
+	sortStack(r.cast(), func(a, b any) bool {
+		return compareOIDs(sprintf("%s", a), sprintf("%s", b)) < 0
+	})
+
+	return r
+}
+
+/*
+Dedupe removes, in place, any [ObjectIdentifier] instance within the receiver whose dot notation value duplicates that of an earlier instance, and returns the receiver. The first occurrence of each value is retained, along with the original order.
+
+Note that the [Ctrls] and [ExtOps] functions produce instances that already decline duplicate values during Push attempts, thus this method is only of use for instances whose contents were assembled by other means (e.g.: through casting).
+*/
+func (r ObjectIdentifiers) Dedupe() ObjectIdentifiers {
+	_r := r.cast()
+	seen := make(map[string]bool, r.Len())
+	for i := 0; i < _r.Len(); i++ {
+		slice, _ := _r.Index(i)
+		key := sprintf("%s", slice)
+		if seen[key] {
+			_r.Remove(i)
+			i--
+			continue
+		}
+		seen[key] = true
+	}
+
+	return r
+}
+
+/*
+ValidateAgainst returns a slice of error instances, one (1) for each [ObjectIdentifier] within the receiver whose dot notation value is not a key within the known registry. Each error names the offending OID.
+
+The known registry maps the dot notation of each recognized LDAP control or extended operation OID to its human-readable name, e.g.:
+
+	"1.2.840.113556.1.4.319": "Simple Paged Results"
+
+This package does not supply a registry of its own. As such, a nil or empty registry shall result in all OIDs being reported. A nil slice is returned if the receiver is zero, or if all OIDs are known.
+*/
+func (r ObjectIdentifiers) ValidateAgainst(known map[string]string) (errs []error) {
+	for i := 0; i < r.Len(); i++ {
+		oid := r.Index(i)
+		if oid.IsZero() {
+			continue
+		}
+
+		if _, found := known[oid.String()]; !found {
+			errs = append(errs, unknownOIDErr(r.Keyword(), oid.String()))
+		}
+	}
+
+	return
+}
+
+/*
+compareOIDs returns an integer describing the numeric arc order of dot notation strings a and b: -1 if a precedes b, 1 if b precedes a, else 0.
+*/
+func compareOIDs(a, b string) int {
+	A, B := split(a, `.`), split(b, `.`)
+	for i := 0; i < len(A) && i < len(B); i++ {
+		// arcs are of arbitrary size; compare by
+		// length before comparing lexically.
+		switch {
+		case len(A[i]) != len(B[i]):
+			if len(A[i]) < len(B[i]) {
+				return -1
+			}
+			return 1
+		case A[i] < B[i]:
+			return -1
+		case A[i] > B[i]:
+			return 1
+		}
+	}
+
+	switch {
+	case len(A) < len(B):
+		return -1
+	case len(A) > len(B):
+		return 1
+	}
+
+	return 0
+}
+
 /*
 Pop wraps the [stackage.Stack.Pop] method.
 */
@@ -394,6 +500,16 @@ func (r ObjectIdentifiers) Pop() (x ObjectIdentifier) {
 	return
 }
 
+/*
+SetDelimiter controls the delimitation scheme employed by the receiver.
+
+By default, the symbolic OR operator (`||`) is used to join values. This scheme can be set using the [MultivalSymbolDelim] integer constant (0), or when run in niladic fashion. Alternatively, a comma (ASCII #44) may be set using the [MultivalCommaDelim] integer constant (1).
+*/
+func (r ObjectIdentifiers) SetDelimiter(i ...int) ObjectIdentifiers {
+	setMultivalDelim(r.cast(), i...)
+	return r
+}
+
 /*
 setQuoteStyle shall set the receiver instance to the quotation scheme defined by integer i.
 */
@@ -423,7 +539,9 @@ func (r ObjectIdentifiers) setExpressionValues(key TargetKeyword, values ...stri
 
 		// Identify this objectIdentifier value
 		// as O, as referenced by index integer i.
-		O := values[i]
+		// Padding around any `||` delimiters is
+		// not significant.
+		O := trimS(values[i])
 
 		// Attempt to parse the raw object Identifier
 		// (O) dot notation value using go-objectid.
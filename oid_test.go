@@ -2,6 +2,7 @@ package aci
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -560,3 +561,153 @@ func ExampleObjectIdentifier_TRM() {
 	fmt.Printf("Allows greater-than: %t", oid.TRM().Contains(Gt))
 	// Output: Allows greater-than: false
 }
+
+func ExampleObjectIdentifiers_SetDelimiter() {
+	ctrls := Ctrls(`1.2.840.113556.1.4.805`, `1.2.840.113556.1.4.473`)
+	fmt.Println(ctrls.SetDelimiter(MultivalCommaDelim))
+	// Output: 1.2.840.113556.1.4.805,1.2.840.113556.1.4.473
+}
+
+func TestObjectIdentifiers_SetDelimiter(t *testing.T) {
+	uas := UAs(`cn`, `sn`)
+	if got := uas.SetDelimiter(MultivalSymbolDelim).String(); got != `cn,sn` {
+		t.Errorf("%s failed: URI attributes delimiter altered: %s", t.Name(), got)
+		return
+	}
+
+	exts := ExtOps(`1.3.6.1.4.1.1466.20037`, `1.3.6.1.4.1.4203.1.11.1`)
+	want := exts.String()
+	if got := exts.SetDelimiter(MultivalCommaDelim).SetDelimiter(7).String(); got != want {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), want, got)
+		return
+	}
+}
+
+func ExampleObjectIdentifiers_Sort() {
+	ctrls := Ctrls(`1.3.6.1.4.1.42.2.27.9.5.2`, `1.2.840.113556.1.4.319`, `1.3.6.1.4.1.4203.1.10.1`)
+	fmt.Printf("%s", ctrls.Sort())
+	// Output: 1.2.840.113556.1.4.319 || 1.3.6.1.4.1.42.2.27.9.5.2 || 1.3.6.1.4.1.4203.1.10.1
+}
+
+func TestObjectIdentifiers_Sort(t *testing.T) {
+	oids := ExtOps(`1.3.6.1.4.1.10`, `1.3.6.1.4.1.2.5`, `1.3.6.1.4.1.2`, `1.3.6.1.4.1.9`)
+	want := `( extop = "1.3.6.1.4.1.2 || 1.3.6.1.4.1.2.5 || 1.3.6.1.4.1.9 || 1.3.6.1.4.1.10" )`
+	if got := oids.Sort().Eq().String(); got != want {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), want, got)
+		return
+	}
+
+	// receiver was sorted in place
+	if got := oids.Index(0).String(); got != `1.3.6.1.4.1.2` {
+		t.Errorf("%s failed: want %s, got %s", t.Name(), `1.3.6.1.4.1.2`, got)
+		return
+	}
+
+	var zero ObjectIdentifiers
+	if !zero.Sort().IsZero() {
+		t.Errorf("%s failed: expected zero %T", t.Name(), zero)
+	}
+}
+
+func ExampleObjectIdentifiers_Dedupe() {
+	ops := ExtOps(`1.3.6.1.4.1.56521.999.5`, `1.3.6.1.4.1.56521.999.6`)
+	fmt.Printf("%s", ops.Dedupe())
+	// Output: 1.3.6.1.4.1.56521.999.5 || 1.3.6.1.4.1.56521.999.6
+}
+
+func TestObjectIdentifiers_Dedupe(t *testing.T) {
+	const dup = `1.3.6.1.4.1.56521.999.5`
+
+	// Push declines duplicates outright
+	ops := ExtOps(dup, dup).Push(dup, ExtOp(dup))
+	if ops.Len() != 1 {
+		t.Errorf("%s failed: want 1 value, got %d (%s)", t.Name(), ops.Len(), ops)
+		return
+	}
+
+	// Parsed duplicates are likewise collapsed
+	for idx, raw := range []string{
+		`( extop = "` + dup + ` || 1.3.6.1.4.1.56521.999.6 || ` + dup + `" )`,
+		`( targetcontrol = "` + dup + `" || "1.3.6.1.4.1.56521.999.6" || "` + dup + `" )`,
+	} {
+		tr, err := ParseTargetRules(raw)
+		if err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		}
+
+		oids, ok := tr.Index(0).Expression().(ObjectIdentifiers)
+		if !ok || oids.Len() != 2 {
+			t.Errorf("%s[%d] failed: want 2 unique values, got %s", t.Name(), idx, tr)
+			return
+		}
+	}
+
+	// Stacks assembled by other means may bear duplicates
+	ops = ExtOps()
+	_ops := ops.cast()
+	_ops.SetPushPolicy(func(...any) error { return nil })
+	_ops.Push(ExtOp(dup), ExtOp(dup), ExtOp(`1.3.6.1.4.1.56521.999.6`), ExtOp(dup))
+
+	want := dup + ` || 1.3.6.1.4.1.56521.999.6`
+	if got := ops.Dedupe().String(); got != want {
+		t.Errorf("%s failed: want %s, got %s", t.Name(), want, got)
+		return
+	}
+
+	var zero ObjectIdentifiers
+	if !zero.Dedupe().IsZero() {
+		t.Errorf("%s failed: expected zero %T", t.Name(), zero)
+	}
+}
+
+func ExampleObjectIdentifiers_ValidateAgainst() {
+	known := map[string]string{
+		`1.2.840.113556.1.4.319`: `Simple Paged Results`,
+		`1.2.840.113556.1.4.473`: `Server Side Sort Request`,
+	}
+
+	ctrls := Ctrls(`1.2.840.113556.1.4.319`, `1.2.840.113556.1.4.437`)
+	for _, err := range ctrls.ValidateAgainst(known) {
+		fmt.Println(err)
+	}
+	// Output: Unknown targetcontrol OID '1.2.840.113556.1.4.437'; not found in registry
+}
+
+func TestObjectIdentifiers_ValidateAgainst(t *testing.T) {
+	known := map[string]string{
+		`1.3.6.1.4.1.4203.1.11.1`: `Modify Password`,
+		`1.3.6.1.4.1.4203.1.11.3`: `Who am I?`,
+	}
+
+	ops := ExtOps(`1.3.6.1.4.1.4203.1.11.1`, `1.3.6.1.4.1.4203.1.11.3`)
+	if errs := ops.ValidateAgainst(known); errs != nil {
+		t.Errorf("%s failed: want no errors, got %v", t.Name(), errs)
+		return
+	}
+
+	ops.Push(`1.3.6.1.4.1.4203.1.11.9`, `1.3.6.1.4.1.4203.1.11.7`)
+	errs := ops.ValidateAgainst(known)
+	if len(errs) != 2 {
+		t.Errorf("%s failed: want 2 errors, got %d (%v)", t.Name(), len(errs), errs)
+		return
+	}
+
+	for i, oid := range []string{`1.3.6.1.4.1.4203.1.11.9`, `1.3.6.1.4.1.4203.1.11.7`} {
+		if !strings.Contains(errs[i].Error(), `'`+oid+`'`) {
+			t.Errorf("%s failed: error '%v' does not name %s", t.Name(), errs[i], oid)
+			return
+		}
+	}
+
+	// no registry means nothing is known
+	if errs = ops.ValidateAgainst(nil); len(errs) != ops.Len() {
+		t.Errorf("%s failed: want %d errors, got %d", t.Name(), ops.Len(), len(errs))
+		return
+	}
+
+	var zero ObjectIdentifiers
+	if errs = zero.ValidateAgainst(known); errs != nil {
+		t.Errorf("%s failed: want no errors for zero instance, got %v", t.Name(), errs)
+	}
+}
@@ -5,25 +5,312 @@ parse.go is a bridge to the go-antlraci package
 */
 
 import (
+	"errors"
+
 	parser "github.com/JesseCoretta/go-antlraci"
 )
 
+/*
+ParseOption is a parsing option which overrides the [LenientBindQuotes] and [LenientTargetAttr] global variables for a single call of a parsing function or Parse method, for example:
+
+	var i Instruction
+	err := i.Parse(raw, ParseOption{LenientBindQuotes: true, LenientTargetAttr: true})
+
+This allows legacy input to be tolerated without altering global state, which may be unsafe in the presence of concurrent parsers. Each field enables the tolerance of the same name. If more than one [ParseOption] is submitted, the last one wins. If none are submitted, the global variables apply.
+*/
+type ParseOption struct {
+	LenientBindQuotes bool // see the LenientBindQuotes global variable
+	LenientTargetAttr bool // see the LenientTargetAttr global variable
+}
+
+/*
+parseOption returns the last [ParseOption] within opts or, if opts is empty, a [ParseOption] reflecting the [LenientBindQuotes] and [LenientTargetAttr] global variables.
+*/
+func parseOption(opts []ParseOption) ParseOption {
+	if n := len(opts); n > 0 {
+		return opts[n-1]
+	}
+
+	return ParseOption{
+		LenientBindQuotes: LenientBindQuotes,
+		LenientTargetAttr: LenientTargetAttr,
+	}
+}
+
+/*
+LenientBindQuotes is a global variable that controls whether [BindRule] values lacking double-quotation, such as those emitted by certain legacy tools, shall be tolerated during parsing. When enabled, single-quoted (e.g.: `userdn='ldap:///anyone'`) and unquoted (e.g.: `userdn=ldap:///anyone`) values are normalized to the double-quoted form prior to parsing.
+
+This option is disabled by default, meaning such values are rejected. It applies to [ParseBindRule], [ParseBindRules], [ParseInstructions], [ACIsFromStrings] and to the Parse methods of [BindRule], [BindRules], [PermissionBindRule], [PermissionBindRules] and [Instruction], unless overridden by a [ParseOption] submitted to the same call.
+
+This value is read without synchronization, thus it should not be altered while other goroutines are parsing; see [ParseOption] for a per-call alternative.
+*/
+var LenientBindQuotes bool
+
+/*
+lenientBindQuotes returns raw unchanged if the LenientBindQuotes field of opt is disabled. Otherwise, any single-quoted or unquoted [BindRule] values found within raw are rewritten using double-quotation. Double-quoted regions, such as ACL labels and [TargetRule] values, are passed through as-is.
+*/
+func lenientBindQuotes(raw string, opt ParseOption) string {
+	if !opt.LenientBindQuotes {
+		return raw
+	}
+
+	var out []byte
+	for i := 0; i < len(raw); {
+		switch c := raw[i]; {
+		case c == '"':
+			j := closingQuote(raw, i)
+			out = append(out, raw[i:j]...)
+			i = j
+		case isASCIILetter(c) && (i == 0 || !isWordChar(raw[i-1])):
+			j := i
+			for j < len(raw) && isWordChar(raw[j]) {
+				j++
+			}
+			out = append(out, raw[i:j]...)
+			if matchBKW(raw[i:j]) != BindKeyword(0x0) {
+				if k := bindOperatorEnd(raw, j); k > j {
+					out = append(out, raw[j:k]...)
+					out, j = quoteBindValues(raw, k, out)
+				}
+			}
+			i = j
+		default:
+			out = append(out, c)
+			i++
+		}
+	}
+
+	return string(out)
+}
+
+/*
+LenientTargetAttr is a global variable that controls whether a [TargetAttr] (targetattr) wildcard lacking double-quotation, such as `(targetattr=*)` as emitted by certain directory products, shall be tolerated during parsing. When enabled, the unquoted wildcard is normalized to the double-quoted form prior to parsing, regardless of the comparison operator or spacing in use, thus yielding the canonical `( targetattr = "*" )` upon string representation.
+
+This option is disabled by default, meaning such values are rejected. It applies to [ParseTargetRule], [ParseTargetRules], [ParseInstructions], [ACIsFromStrings] and to the Parse methods of [TargetRule], [TargetRules] and [Instruction], unless overridden by a [ParseOption] submitted to the same call.
+
+This value is read without synchronization, thus it should not be altered while other goroutines are parsing; see [ParseOption] for a per-call alternative.
+*/
+var LenientTargetAttr bool
+
+/*
+lenientTargetAttr returns raw unchanged if the LenientTargetAttr field of opt is disabled. Otherwise, any unquoted lone wildcard (*) assigned to a targetattr keyword within raw is rewritten using double-quotation. Double-quoted regions, such as ACL labels and [BindRule] values, are passed through as-is.
+*/
+func lenientTargetAttr(raw string, opt ParseOption) string {
+	if !opt.LenientTargetAttr {
+		return raw
+	}
+
+	var out []byte
+	for i := 0; i < len(raw); {
+		switch c := raw[i]; {
+		case c == '"':
+			j := closingQuote(raw, i)
+			out = append(out, raw[i:j]...)
+			i = j
+		case isASCIILetter(c) && (i == 0 || !isWordChar(raw[i-1])):
+			j := i
+			for j < len(raw) && isWordChar(raw[j]) {
+				j++
+			}
+			out = append(out, raw[i:j]...)
+			if eq(raw[i:j], TargetAttr.String()) {
+				if k := bindOperatorEnd(raw, j); k > j && isLoneWildcard(raw, k) {
+					out = append(out, raw[j:k]...)
+					out = append(out, `"*"`...)
+					j = k + 1
+				}
+			}
+			i = j
+		default:
+			out = append(out, c)
+			i++
+		}
+	}
+
+	return string(out)
+}
+
+/*
+isLoneWildcard returns a Boolean value indicative of whether index i of raw bears an unquoted wildcard (*) which is followed only by optional spaces and the closing parenthesis of a [TargetRule].
+*/
+func isLoneWildcard(raw string, i int) bool {
+	if i >= len(raw) || raw[i] != '*' {
+		return false
+	}
+
+	j := skipSpaces(raw, i+1)
+	return j < len(raw) && raw[j] == ')'
+}
+
+/*
+bindOperatorEnd returns the index following the comparison operator -- and any surrounding spaces -- found at index i of raw. If no operator is present, i is returned.
+*/
+func bindOperatorEnd(raw string, i int) int {
+	j := skipSpaces(raw, i)
+	switch {
+	case hasPfx(raw[j:], `!=`), hasPfx(raw[j:], `>=`), hasPfx(raw[j:], `<=`):
+		j += 2
+	case hasPfx(raw[j:], `=`), hasPfx(raw[j:], `>`), hasPfx(raw[j:], `<`):
+		j++
+	default:
+		return i
+	}
+
+	return skipSpaces(raw, j)
+}
+
+/*
+quoteBindValues appends to out the double-quoted form of the one (1) or more symbolic OR (||) delimited values found at index i of raw. The updated output and the index following the last value are returned.
+*/
+func quoteBindValues(raw string, i int, out []byte) ([]byte, int) {
+	for i < len(raw) {
+		switch raw[i] {
+		case '"':
+			j := closingQuote(raw, i)
+			out = append(out, raw[i:j]...)
+			i = j
+		case '\'':
+			j := closingQuote(raw, i)
+			if raw[j-1] != '\'' || j-i < 2 {
+				// unterminated; leave it for the parser to reject
+				return append(out, raw[i:j]...), j
+			}
+			out = append(out, '"')
+			out = append(out, raw[i+1:j-1]...)
+			out = append(out, '"')
+			i = j
+		default:
+			j := unquotedValueEnd(raw, i)
+			if j == i {
+				return out, i
+			}
+			out = append(out, '"')
+			out = append(out, raw[i:j]...)
+			out = append(out, '"')
+			i = j
+		}
+
+		k := skipSpaces(raw, i)
+		if !hasPfx(raw[k:], `||`) {
+			break
+		}
+		k = skipSpaces(raw, k+2)
+		out = append(out, raw[i:k]...)
+		i = k
+	}
+
+	return out, i
+}
+
+/*
+unquotedValueEnd returns the index at which the unquoted value beginning at index i of raw ends, which is the first unbalanced closing parenthesis, or the first space, semicolon or symbolic OR (||) found outside of parentheses.
+*/
+func unquotedValueEnd(raw string, i int) int {
+	var depth int
+	for ; i < len(raw); i++ {
+		switch raw[i] {
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		case ' ', ';':
+			if depth == 0 {
+				return i
+			}
+		case '|':
+			if depth == 0 && hasPfx(raw[i:], `||`) {
+				return i
+			}
+		}
+	}
+
+	return i
+}
+
+/*
+closingQuote returns the index following the quotation character which terminates the quoted region beginning at index i of raw. The length of raw is returned if the region is unterminated.
+*/
+func closingQuote(raw string, i int) int {
+	for j := i + 1; j < len(raw); j++ {
+		if raw[j] == raw[i] {
+			return j + 1
+		}
+	}
+
+	return len(raw)
+}
+
+/*
+skipSpaces returns the index of the first non-space character found at or after index i of raw.
+*/
+func skipSpaces(raw string, i int) int {
+	for i < len(raw) && raw[i] == ' ' {
+		i++
+	}
+
+	return i
+}
+
+/*
+isASCIILetter returns a Boolean value indicative of whether c is an ASCII letter.
+*/
+func isASCIILetter(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+/*
+isWordChar returns a Boolean value indicative of whether c may appear within a keyword or other bare word.
+*/
+func isWordChar(c byte) bool {
+	return isASCIILetter(c) || ('0' <= c && c <= '9') || c == '_' || c == '-'
+}
+
+/*
+narrowValues returns a copy of values in which each value has been passed through narrowRunes. This counters the handling of multibyte characters within DN values by the [parser] package, which widens each byte of the input into a rune of its own, e.g.: `José` arrives as `JosÃ©`.
+*/
+func narrowValues(values []string) (narrowed []string) {
+	narrowed = make([]string, len(values))
+	for i, value := range values {
+		narrowed[i] = narrowRunes(value)
+	}
+
+	return
+}
+
+/*
+narrowRunes reverses the byte widening performed by the [parser] package exactly once, restoring each rune within x to the single byte from which it originated. Any value bearing a rune outside of the byte range cannot have originated in this manner, and is returned as-is.
+*/
+func narrowRunes(x string) string {
+	b := make([]byte, 0, len(x))
+	for _, r := range x {
+		if r > 0xFF {
+			return x
+		}
+		b = append(b, byte(r))
+	}
+
+	return string(b)
+}
+
 /*
 ParseBindRule returns an instance of [BindRule] alongside an error instance.
 
 This function calls the imported [parser.ParseBindRule] function, delegating
 parsing responsibilities there.
 */
-func ParseBindRule(raw string) (BindRule, error) {
-	return parseBindRule(raw)
+func ParseBindRule(raw string, opts ...ParseOption) (BindRule, error) {
+	return parseBindRule(raw, opts...)
 }
 
 /*
 Parse returns an error instance following an attempt to parse the raw input value
 into the receiver instance.
 */
-func (r *BindRule) Parse(raw string) error {
-	_r, err := parseBindRule(raw)
+func (r *BindRule) Parse(raw string, opts ...ParseOption) error {
+	_r, err := parseBindRule(raw, opts...)
 	if err != nil {
 		return err
 	}
@@ -32,16 +319,16 @@ func (r *BindRule) Parse(raw string) error {
 	return nil
 }
 
-func parseBindRule(raw string) (BindRule, error) {
-	_r, err := parser.ParseBindRule(raw)
+func parseBindRule(raw string, opts ...ParseOption) (BindRule, error) {
+	_r, err := parser.ParseBindRule(lenientBindQuotes(raw, parseOption(opts)))
 	return BindRule(_r), err
 }
 
 /*
 ParseBindRules returns an instance of [BindContext] alongside an error instance. [BindContext] may represent either a [BindRule] or [BindRules] instance, depending on that which was parsed.
 */
-func ParseBindRules(raw string) (BindContext, error) {
-	return parseBindRules(raw)
+func ParseBindRules(raw string, opts ...ParseOption) (BindContext, error) {
+	return parseBindRules(raw, opts...)
 }
 
 /*
@@ -49,8 +336,8 @@ Parse returns an error based upon an attempt to parse the raw input value into t
 
 Both this method, and the package-level [ParseBindRules] function, call [parser.ParseBindRule] function in similar fashion. The only real difference here is the process of writing to a receiver, versus writing to an uninitialized variable declaration.
 */
-func (r *BindRules) Parse(raw string) error {
-	_r, err := parseBindRules(raw)
+func (r *BindRules) Parse(raw string, opts ...ParseOption) error {
+	_r, err := parseBindRules(raw, opts...)
 	if err != nil {
 		return err
 	}
@@ -66,11 +353,11 @@ func (r *BindRules) Parse(raw string) error {
 /*
 parseBindRules communicates with the imported [parser] package for the purpose of parsing an instance of [BindRules], which is returned alongside an error.
 */
-func parseBindRules(raw string) (BindContext, error) {
+func parseBindRules(raw string, opts ...ParseOption) (BindContext, error) {
 	// In case the input has bizarre
 	// contiguous whsp, etc., remove
 	// it safely.
-	raw = condenseWHSP(raw)
+	raw = lenientBindQuotes(condenseWHSP(raw), parseOption(opts))
 
 	// send the raw textual bind rules
 	// statement(s) to our sister package
@@ -314,7 +601,7 @@ func assertBindUGRDN(expr parser.RuleExpression, key BindKeyword) (ex any, err e
 	// Assign the raw (DN) values to the
 	// return value. If nothing was found,
 	// bail out now.
-	if err = bdn.setExpressionValues(key, expr.Values...); err == nil {
+	if err = bdn.setExpressionValues(key, narrowValues(expr.Values)...); err == nil {
 		// Envelope our DN stack within an
 		// 'any' instance, which is returned.
 		ex = bdn
@@ -394,15 +681,15 @@ func (r BindRule) assertExpressionValue() (err error) {
 /*
 ParseTargetRule processes the raw input string value, which should represent a single [TargetRule] expressive statement, into an instance of [TargetRule]. This, along with an error instance, are returned upon completion of processing.
 */
-func ParseTargetRule(raw string) (TargetRule, error) {
-	return parseTargetRule(raw)
+func ParseTargetRule(raw string, opts ...ParseOption) (TargetRule, error) {
+	return parseTargetRule(raw, opts...)
 }
 
 /*
 parseTargetRule is a private function which converts the stock stackage.Condition instance assembled by antlraci and casts as a go-aci [TargetRule] instance, which will be returned alongside an error upon completion of processing.
 */
-func parseTargetRule(raw string) (TargetRule, error) {
-	_t, err := parser.ParseTargetRule(raw)
+func parseTargetRule(raw string, opts ...ParseOption) (TargetRule, error) {
+	_t, err := parser.ParseTargetRule(lenientTargetAttr(raw, parseOption(opts)))
 	t := TargetRule(_t)
 	t.assertExpressionValue()
 	return t, err
@@ -413,8 +700,8 @@ Parse returns an error based upon an attempt to parse the raw input value into t
 
 Both this method, and the package-level [ParseTargetRule] function, call the [parser.ParseTargetRule] function in similar fashion. The only real difference here is the process of writing to a receiver, versus writing to an uninitialized variable declaration.
 */
-func (r *TargetRule) Parse(raw string) error {
-	_r, err := parseTargetRule(raw)
+func (r *TargetRule) Parse(raw string, opts ...ParseOption) error {
+	_r, err := parseTargetRule(raw, opts...)
 	if err != nil {
 		return err
 	}
@@ -428,8 +715,8 @@ Parse returns an error based upon an attempt to parse the raw input value into t
 
 Both this method, and the package-level [ParseTargetRules] function, call the [parser.ParseTargetRules] function in similar fashion. The only real difference here is the process of writing to a receiver, versus writing to an uninitialized variable declaration.
 */
-func (r *TargetRules) Parse(raw string) error {
-	_r, err := parseTargetRules(raw)
+func (r *TargetRules) Parse(raw string, opts ...ParseOption) error {
+	_r, err := parseTargetRules(raw, opts...)
 	if err != nil {
 		return err
 	}
@@ -441,18 +728,18 @@ func (r *TargetRules) Parse(raw string) error {
 /*
 ParseTargetRules processes the raw input string value, which should represent one (1) or more valid [TargetRule] expressive statements, into an instance of [TargetRules]. This, alongside an error instance, are returned at the completion of processing.
 */
-func ParseTargetRules(raw string) (TargetRules, error) {
-	return parseTargetRules(raw)
+func ParseTargetRules(raw string, opts ...ParseOption) (TargetRules, error) {
+	return parseTargetRules(raw, opts...)
 }
 
 /*
 parseTargetRules is a private function which converts the stock stackage.Stack instance assembled by the [parser] package and coaxes the raw string values into proper value-appropriate type instances made available by go-aci.
 */
-func parseTargetRules(raw string) (TargetRules, error) {
+func parseTargetRules(raw string, opts ...ParseOption) (TargetRules, error) {
 	// In case the input has bizarre
 	// contiguous whsp, etc., remove
 	// it safely.
-	raw = condenseWHSP(raw)
+	raw = lenientTargetAttr(condenseWHSP(raw), parseOption(opts))
 
 	// Call our antlraci (parser) package's
 	// ParseTargetRules function, and get the
@@ -684,7 +971,7 @@ func assertTargetTFDN(expr parser.RuleExpression, key TargetKeyword) (ex any, er
 	// Assign the raw (DN) values to the
 	// return value. If nothing was found,
 	// bail out now.
-	if err = tdn.setExpressionValues(key, expr.Values...); err != nil {
+	if err = tdn.setExpressionValues(key, narrowValues(expr.Values)...); err != nil {
 		return
 	}
 
@@ -710,7 +997,7 @@ func assertTargetAttributes(expr parser.RuleExpression) (ex AttributeTypes, err
 	ex.setQuoteStyle(expr.Style)
 
 	for i := 0; i < expr.Len(); i++ {
-		value := unquote(condenseWHSP(expr.Values[0]))
+		value := unquote(condenseWHSP(expr.Values[i]))
 		if len(value) == 0 {
 			err = nilInstanceErr(AttributeType{})
 			return
@@ -789,9 +1076,8 @@ func assertTargetScope(expr parser.RuleExpression) (ex SearchScope, err error) {
 	}
 
 	var temp SearchScope
-	// base is a fallback for a bogus scope, so
-	// if the user did not originally request
-	// base, we know they requested something
+	// noScope is returned for any unknown scope
+	// name, meaning the user requested something
 	// totally unsupported.
 	if temp = strToScope(value); temp == noScope {
 		err = bogusValueErr(TargetScope.String(), value)
@@ -887,8 +1173,8 @@ func unpackageAntlrPermission(perm parser.Permission) (*permission, error) {
 	return p, err
 }
 
-func parsePermissionBindRule(raw string) (PermissionBindRule, error) {
-	pbr, err := parser.ParsePermissionBindRule(raw)
+func parsePermissionBindRule(raw string, opts ...ParseOption) (PermissionBindRule, error) {
+	pbr, err := parser.ParsePermissionBindRule(lenientBindQuotes(raw, parseOption(opts)))
 	if err != nil {
 		return badPermissionBindRule, err
 	}
@@ -941,8 +1227,8 @@ Parse wraps the [parser.ParsePermissionBindRule] function, writing
 valid data into the receiver, or returning an error instance should
 processing fail.
 */
-func (r *PermissionBindRule) Parse(raw string) error {
-	_r, err := parsePermissionBindRule(raw)
+func (r *PermissionBindRule) Parse(raw string, opts ...ParseOption) error {
+	_r, err := parsePermissionBindRule(raw, opts...)
 	if err != nil {
 		return err
 	}
@@ -956,8 +1242,8 @@ Parse wraps the [parser.ParsePermissionBindRules] function, writing
 valid data into the receiver, or returning an error instance should
 processing fail.
 */
-func (r *PermissionBindRules) Parse(raw string) error {
-	_pbrs, err := parser.ParsePermissionBindRules(raw)
+func (r *PermissionBindRules) Parse(raw string, opts ...ParseOption) error {
+	_pbrs, err := parser.ParsePermissionBindRules(lenientBindQuotes(raw, parseOption(opts)))
 	if err != nil {
 		return err
 	}
@@ -970,6 +1256,61 @@ func (r *PermissionBindRules) Parse(raw string) error {
 	return err
 }
 
+/*
+ParseInstructions returns an instance of [Instructions] alongside an error following an attempt to parse the raw input value, which may contain any number of ACIv3 instruction statements.
+
+The input may be LDIF (RFC 2849) content, such as that produced by a directory export or by [Instruction.LDIF]. Folded (continuation) lines are unfolded, and both the `aci:` and base64-encoded `aci::` attribute forms are honored. All other LDIF lines, as well as comments and blank lines, are ignored.
+
+Alternatively, the input may contain bare instruction statements, one (1) per line.
+
+Statements that cannot be parsed are reported within the (joined) return error, each identified by its ordinal position; all other statements are returned within the [Instructions] instance.
+*/
+func ParseInstructions(raw string, opts ...ParseOption) (i Instructions, err error) {
+	i = ACIs()
+
+	var errs []error
+	for idx, value := range ldifACIValues(raw) {
+		var ins Instruction
+		if value.err == nil {
+			value.err = ins.Parse(value.string, opts...)
+		}
+
+		if value.err != nil {
+			errs = append(errs, instructionIndexErr(idx, value.err))
+			continue
+		}
+		i.Push(ins)
+	}
+
+	err = errors.Join(errs...)
+	return
+}
+
+/*
+ACIsFromStrings returns an instance of [Instructions] containing an [Instruction] parsed from each of the raw ACIv3 instruction statements within raws, alongside slices of error describing each statement that could not be parsed or pushed (e.g.: due to a duplicate). Each error identifies the offending statement by its ordinal position within raws. A nil error slice indicates total success.
+
+Unlike the [ACIs] function, which stores string values as-is, each statement is fully parsed into a queryable [Instruction], and failures are reported rather than silently dropped.
+*/
+func ACIsFromStrings(raws []string, opts ...ParseOption) (i Instructions, errs []error) {
+	i = ACIs()
+
+	for idx, raw := range raws {
+		var ins Instruction
+		err := ins.Parse(raw, opts...)
+		if err == nil {
+			if err = i.pushPolicy(ins); err == nil {
+				i.Push(ins)
+			}
+		}
+
+		if err != nil {
+			errs = append(errs, instructionIndexErr(idx, err))
+		}
+	}
+
+	return
+}
+
 /*
 Parse wraps the [parser.ParseInstruction] package-level function,
 writing data into the receiver, or returning a non-nil instance of
@@ -979,8 +1320,10 @@ WARNING: Note that the act of successfully parsing an ACIv3 instruction
 statement will clobber (overwrite) all of the contents present within the
 receiver, if any.
 */
-func (r *Instruction) Parse(raw string) (err error) {
+func (r *Instruction) Parse(raw string, opts ...ParseOption) (err error) {
+	opt := parseOption(opts)
 	raw = condenseWHSP(raw) // get rid of leading/trailing/contiguous whitespace, newlines, et al.
+	raw = lenientTargetAttr(lenientBindQuotes(raw, opt), opt)
 
 	var (
 		_r parser.Instruction  // instance returned by antlraci
@@ -1049,7 +1392,7 @@ func parseLDAPURI(x string, bkw ...BindKeyword) (L LDAPURI, err error) {
 	// URI absolutely MUST begin with the local
 	// LDAP scheme (e.g.: ldap:///). If it does
 	// not, fail immediately.
-	if !hasPfx(x, LocalScheme) {
+	if !hasPfx(lc(x), LocalScheme) {
 		err = uriBadPrefixErr()
 		return
 	}
@@ -1064,7 +1407,12 @@ func parseLDAPURI(x string, bkw ...BindKeyword) (L LDAPURI, err error) {
 	// iterate each value produced through split
 	// on question mark and massage values into
 	// LDAP URI appropriate component values ...
-	err = l.assertURIComponents(split(uri, `?`), bkw...)
+	vals := split(uri, `?`)
+	if len(vals) > 4 {
+		err = uriComponentCountErr(len(vals))
+		return
+	}
+	err = l.assertURIComponents(vals, bkw...)
 
 	// Envelope ldapURI instance and send it off
 	L = LDAPURI{l}
@@ -1072,17 +1420,115 @@ func parseLDAPURI(x string, bkw ...BindKeyword) (L LDAPURI, err error) {
 	return
 }
 
+/*
+validURIExpression returns an error if any LDAP URI found within the raw (unprocessed) [BindRule] expression value (x) fails to parse. This allows a [BindRule] produced by [ParseBindRule], whose values are not converted into [LDAPURI] instances, to be vetted for malformed attributes, scope or filter components.
+
+Values of any other type, as well as values which are not LDAP URIs, are ignored.
+*/
+func validURIExpression(x any, kw BindKeyword) (err error) {
+	expr, ok := x.(parser.RuleExpression)
+	if !ok {
+		return
+	}
+
+	for i := 0; i < len(expr.Values) && err == nil; i++ {
+		value := unquote(condenseWHSP(expr.Values[i]))
+		if hasPfx(lc(value), LocalScheme) && contains(value, `?`) {
+			_, err = parseLDAPURI(value, kw)
+		}
+	}
+
+	return
+}
+
+/*
+chopURIPfx returns the input value (x) with its LDAP scheme prefix removed alongside an error, which shall be non-nil if the prefix is missing or malformed.
+
+The local scheme (ldap:///) is preferred, however a non-local scheme bearing a hostport (e.g.: ldap://ldap.example.com:389/) is tolerated. In the latter case, the hostport is verified and then discarded, as the local scheme is ALWAYS imposed during string representation.
+
+This function is called by the URL package-level function. Note that parseLDAPURI does NOT call this function, as non-local URIs are not to be honored during ACI parsing.
+*/
+func chopURIPfx(x string) (uri string, err error) {
+	if hasPfx(lc(x), LocalScheme) {
+		uri = x[len(LocalScheme):]
+		return
+	} else if !hasPfx(lc(x), `ldap://`) {
+		err = uriBadPrefixErr()
+		return
+	}
+
+	uri = x[len(`ldap://`):]
+	idx := idxr(uri, '/')
+	if idx == -1 {
+		err = uriBadPrefixErr()
+	} else if !isValidHostPort(uri[:idx]) {
+		err = uriBadHostErr(uri[:idx])
+	} else {
+		uri = uri[idx+1:]
+	}
+
+	return
+}
+
+/*
+isValidHostPort returns a Boolean value indicative of whether the input value represents a valid host, optionally followed by a colon (:) and numerical port. The host may be a domain name, an IPv4 address or a bracketed IPv6 address.
+*/
+func isValidHostPort(x string) bool {
+	host, port := splitHostPort(x)
+	if len(port) > 0 && !isValidPort(port) {
+		return false
+	}
+
+	if hasPfx(host, `[`) && hasSfx(host, `]`) {
+		return isV6(host[1 : len(host)-1])
+	}
+
+	for _, label := range split(host, `.`) {
+		if !validLabel(label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+splitHostPort splits the input value into separate host and port components. The port shall be zero if not present.
+*/
+func splitHostPort(x string) (host, port string) {
+	host = x
+	if hasPfx(x, `[`) {
+		if idx := idxs(x, `]:`); idx != -1 {
+			host, port = x[:idx+1], x[idx+2:]
+		}
+	} else if idx := idxr(x, ':'); idx != -1 {
+		host, port = x[:idx], x[idx+1:]
+	}
+
+	return
+}
+
+/*
+isValidPort returns a Boolean value indicative of whether the input value represents a numerical port between 1 and 65535.
+*/
+func isValidPort(x string) bool {
+	n, err := atoi(x)
+	return err == nil && 0 < n && n <= 65535
+}
+
 /*
 Parse is a convenient alternative to building the receiver instance using individual instances of the needed types. This method does not use the [parser] package.
 
 An error is returned if the parsing attempt fails for some reason. If successful, the receiver pointer is updated (clobbered) with new information.
 
-Parse will process the input string (raw) and attempt to split the value using a delimiter integer identifier, if specified. See [AttributeFilterOperationsCommaDelim] (default) and [AttributeFilterOperationsSemiDelim] constant definitions for details.
+Parse will process the input string (raw) and attempt to split the value using a delimiter integer identifier, if specified. If not specified, the [AttributeFilterOperationsDelim] global variable is honored. See [AttributeFilterOperationsCommaDelim] (default) and [AttributeFilterOperationsSemiDelim] constant definitions for details.
 */
 func (r *AttributeFilterOperations) Parse(raw string, delim ...int) (err error) {
-	var d int = AttributeFilterOperationsCommaDelim
+	var d int = AttributeFilterOperationsDelim
 	if len(delim) > 0 {
-		if delim[0] == AttributeFilterOperationsSemiDelim {
+		switch delim[0] {
+		case AttributeFilterOperationsCommaDelim,
+			AttributeFilterOperationsSemiDelim:
 			d = delim[0]
 		}
 	}
@@ -1096,6 +1542,45 @@ func (r *AttributeFilterOperations) Parse(raw string, delim ...int) (err error)
 	return
 }
 
+/*
+ParseAttributeFilterOperations returns an instance of [AttributeFilterOperations] alongside an error following an attempt to parse raw. Unlike [AttributeFilterOperations.Parse], the delimiter scheme -- comma or semicolon -- is detected automatically and assigned to the return instance through [AttributeFilterOperations.SetDelimiter], thus the string representation of a successful return shall bear the same delimiter as raw.
+
+Delimiters are identified by the `add=` or `delete=` marker that must follow each, thus commas and semicolons that appear within attribute values are not mistaken for delimiters. See [AttributeFilterOperationsCommaDelim] and [AttributeFilterOperationsSemiDelim] for details.
+*/
+func ParseAttributeFilterOperations(raw string) (afos AttributeFilterOperations, err error) {
+	raw = unquote(trimS(raw))
+	if !hasAttributeFilterOperationPrefix(raw) {
+		err = afoMissingPrefixErr()
+		return
+	}
+
+	var (
+		vals  []string
+		delim int
+		sep   string
+	)
+	if vals, delim, sep, err = splitAttributeFilterOperations(raw); err != nil {
+		return
+	}
+
+	_afos := AFOs().SetDelimiter(delim)
+	if len(sep) > 1 {
+		// retain the original spacing
+		// around the delimiter.
+		_afos.cast().SetDelimiter(sep)
+	}
+	for i := 0; i < len(vals); i++ {
+		var afo AttributeFilterOperation
+		if afo, err = parseAttributeFilterOperation(trimS(vals[i])); err != nil {
+			return
+		}
+		_afos.Push(afo)
+	}
+	afos = _afos
+
+	return
+}
+
 /*
 Parse returns an error instance following an attempt to parse input raw into the receiver instance. A successful parse will clobber (or obliterate) any contents already present within the receiver.
 */
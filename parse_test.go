@@ -995,3 +995,185 @@ func ExampleTargetRule_Parse() {
 	fmt.Printf("%s", tr.Expression())
 	// Output: aci
 }
+
+/*
+This example demonstrates the opt-in tolerance of single-quoted and unquoted
+bind rule values, such as those emitted by certain legacy tools.
+*/
+func ExampleParseOption() {
+	br, err := ParseBindRules(`( userdn = 'ldap:///anyone' AND ssf >= 128 )`,
+		ParseOption{LenientBindQuotes: true})
+	if err != nil {
+		fmt.Println(err) // always check your parser errors
+		return
+	}
+
+	fmt.Printf("%s", br)
+	// Output: ( userdn = "ldap:///anyone" AND ssf >= "128" )
+}
+
+func TestLenientBindQuotes(t *testing.T) {
+	for idx, raw := range []string{
+		`userdn = ldap:///uid=jesse,ou=People,dc=example,dc=com`,
+		`userdn = 'ldap:///uid=jesse,ou=People,dc=example,dc=com'`,
+		`(userdn='ldap:///ou=People,dc=example,dc=com??sub?(uid=*)' OR ssf>=128)`,
+		`allow(read) groupdn=ldap:///cn=Staff,ou=Groups,dc=example,dc=com;`,
+		`(targetattr="cn")(version 3.0; acl "userdn=x"; allow(read) userdn=ldap:///anyone;)`,
+	} {
+		// strict mode (default) must reject the input
+		if _, err := lenientParse(raw); err == nil {
+			t.Errorf("%s[%d] failed: expected error in strict mode, got nil", t.Name(), idx)
+			return
+		}
+
+		s, err := lenientParse(raw, ParseOption{LenientBindQuotes: true})
+		if err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		} else if contains(s, `'`) || !contains(s, `"ldap:///`) {
+			t.Errorf("%s[%d] failed: value not normalized: %s", t.Name(), idx, s)
+			return
+		}
+
+		// the global variable applies absent a per-call option,
+		// which in turn overrides the global variable.
+		LenientBindQuotes = true
+		_, gerr := lenientParse(raw)
+		_, oerr := lenientParse(raw, ParseOption{})
+		LenientBindQuotes = false
+		if gerr != nil || oerr == nil {
+			t.Errorf("%s[%d] failed: global: %v, override: %v", t.Name(), idx, gerr, oerr)
+			return
+		}
+	}
+
+	// double-quoted regions, such as ACL labels, are left alone
+	want := `(target="ldap:///dc=example,dc=com")(version 3.0; acl "userdn = x"; allow(read) userdn = "ldap:///anyone";)`
+	if got := lenientBindQuotes(`(target="ldap:///dc=example,dc=com")(version 3.0; acl "userdn = x"; allow(read) userdn = ldap:///anyone;)`,
+		ParseOption{LenientBindQuotes: true}); got != want {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), want, got)
+	}
+}
+
+func ExampleParseOption_lenientTargetAttr() {
+	var i Instruction
+	if err := i.Parse(`(targetattr=*)(version 3.0; acl "All attributes"; allow(read) userdn='ldap:///anyone';)`,
+		ParseOption{LenientBindQuotes: true, LenientTargetAttr: true}); err != nil {
+		fmt.Println(err) // always check your parser errors
+		return
+	}
+
+	fmt.Printf("%s", i.TRs())
+	// Output: ( targetattr = "*" )
+}
+
+func TestLenientTargetAttr(t *testing.T) {
+	for idx, raw := range []string{
+		`(targetattr=*)`,
+		`( targetattr = * )`,
+		`(targetattr!=*)(targetfilter="(cn=*)")`,
+		`(target="ldap:///ou=People,dc=example,dc=com")(targetattr =*)`,
+	} {
+		// strict mode (default) must reject the input
+		if _, err := ParseTargetRules(raw); err == nil {
+			t.Errorf("%s[%d] failed: expected error in strict mode, got nil", t.Name(), idx)
+			return
+		}
+
+		trs, err := ParseTargetRules(raw, ParseOption{LenientTargetAttr: true})
+		if err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		} else if s := trs.String(); !contains(s, `targetattr = "*"`) && !contains(s, `targetattr != "*"`) {
+			t.Errorf("%s[%d] failed: value not normalized: %s", t.Name(), idx, s)
+			return
+		}
+	}
+
+	opt := ParseOption{LenientTargetAttr: true}
+
+	// non-wildcard unquoted values are still rejected
+	if _, err := ParseTargetRule(`(targetattr=*cn)`, opt); err == nil {
+		t.Errorf("%s failed: expected error for partial wildcard, got nil", t.Name())
+		return
+	}
+
+	// the global variable applies absent a per-call option
+	LenientTargetAttr = true
+	_, err := ParseTargetRule(`(targetattr=*)`)
+	LenientTargetAttr = false
+	if err != nil {
+		t.Errorf("%s failed: global variable not honored: %v", t.Name(), err)
+		return
+	}
+
+	// double-quoted regions, such as ACL labels, are left alone
+	want := `(targetattr="*")(version 3.0; acl "targetattr=*)"; allow(read) userdn="ldap:///anyone";)`
+	if got := lenientTargetAttr(`(targetattr=*)(version 3.0; acl "targetattr=*)"; allow(read) userdn="ldap:///anyone";)`, opt); got != want {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), want, got)
+	}
+}
+
+/*
+lenientParse dispatches raw to the appropriate parser based upon its apparent
+construct, returning the string representation of the result.
+*/
+func lenientParse(raw string, opts ...ParseOption) (string, error) {
+	switch {
+	case hasPfx(raw, `(target`):
+		var i Instruction
+		err := i.Parse(raw, opts...)
+		return i.String(), err
+	case hasPfx(raw, `allow`):
+		var pbr PermissionBindRule
+		err := pbr.Parse(raw, opts...)
+		return pbr.String(), err
+	}
+
+	br, err := ParseBindRules(raw, opts...)
+	if err != nil {
+		return ``, err
+	}
+	return br.String(), err
+}
+
+func ExampleACIsFromStrings() {
+	acis, errs := ACIsFromStrings([]string{
+		`(targetattr="cn")(version 3.0; acl "Anonymous reads"; allow(read) userdn="ldap:///anyone";)`,
+		`(targetattr="cn")(version 3.0; acl "Broken"; allow(read) userdn=;)`,
+	})
+
+	fmt.Println(acis.Len(), len(errs), acis.Index(0).ACL())
+	// Output: 1 1 Anonymous reads
+}
+
+func TestACIsFromStrings(t *testing.T) {
+	good := ACI(`Self writes`, TRs().Push(TAs(`mail`).Eq()), PBR(Allow(WriteAccess), SelfDN.Eq()))
+	acis, errs := ACIsFromStrings([]string{
+		good.String(),
+		`bogus`,
+		good.String(),
+	})
+
+	if acis.Len() != 1 {
+		t.Errorf("%s failed: want 1 %T, got %d", t.Name(), good, acis.Len())
+		return
+	} else if got := acis.Index(0); got.String() != good.String() || got.ACL() != good.ACL() {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), good, got)
+		return
+	} else if len(errs) != 2 {
+		t.Errorf("%s failed: want 2 errors, got %d: %v", t.Name(), len(errs), errs)
+		return
+	}
+
+	for i, want := range []string{`#1 is invalid`, `#2 is invalid`} {
+		if !contains(errs[i].Error(), want) {
+			t.Errorf("%s[%d] failed: want error containing %q, got %v", t.Name(), i, want, errs[i])
+			return
+		}
+	}
+
+	if acis, errs = ACIsFromStrings(nil); acis.Len() != 0 || errs != nil {
+		t.Errorf("%s failed: unexpected result for nil input: %d, %v", t.Name(), acis.Len(), errs)
+	}
+}
@@ -58,6 +58,30 @@ func PBR(P Permission, B BindContext) (r PermissionBindRule) {
 	return
 }
 
+/*
+GrantAll returns an instance of [PermissionBindRules] containing one (1) [PermissionBindRule] instance per [Permission] provided, each of which bears the same [BindContext] (b). This is merely a convenient alternative to repeating the same [BindContext] manually across multiple [PBR] calls.
+
+Any [Permission] that fails validity checks is silently skipped, as is any [Permission] whose resultant [PermissionBindRule] already resides within the return instance. Each [PermissionBindRule] within the return instance shall have passed validity checks.
+
+An empty [PermissionBindRules] instance is returned if b is nil or invalid.
+*/
+func GrantAll(b BindContext, perms ...Permission) (pbrs PermissionBindRules) {
+	pbrs = PBRs()
+	if b == nil {
+		return
+	} else if err := b.Valid(); err != nil {
+		return
+	}
+
+	for i := 0; i < len(perms); i++ {
+		if pbr := PBR(perms[i], b); !pbr.IsZero() {
+			pbrs.Push(pbr)
+		}
+	}
+
+	return
+}
+
 /*
 Valid returns an error instance should any of the following conditions evaluate as true:
 
@@ -69,6 +93,63 @@ func (r PermissionBindRule) Valid() (err error) {
 	return r.valid()
 }
 
+/*
+Explain returns slices of string, each of which describes a single validity problem found within the receiver instance. Unlike [PermissionBindRule.Valid], which halts upon the first problem encountered, Explain reports all problems that can be found, including:
+
+  - an unset, or nil, receiver instance
+  - an unset [Permission], or one lacking a disposition (allow or deny)
+  - an unset, empty or invalid [BindContext]
+  - any [BindRule], at any depth, bearing an unknown [BindKeyword]
+
+A zero length return indicates no problems were found.
+*/
+func (r PermissionBindRule) Explain() (problems []string) {
+	problems = []string{}
+	if r.permissionBindRule == nil {
+		problems = append(problems, nilInstanceErr(r).Error())
+		return
+	}
+
+	if err := r.permissionBindRule.P.Valid(); err != nil {
+		problems = append(problems, err.Error())
+	}
+
+	if r.permissionBindRule.B == nil {
+		problems = append(problems, nilInstanceErr(r.permissionBindRule.B).Error())
+		return
+	}
+
+	return append(problems, explainBindContext(r.permissionBindRule.B)...)
+}
+
+/*
+explainBindContext is a private function called by PermissionBindRule.Explain, as well as by itself (recursively) for nested [BindRules] instances.
+*/
+func explainBindContext(b BindContext) (problems []string) {
+	if b == nil || b.IsZero() {
+		return []string{nilInstanceErr(b).Error()}
+	}
+
+	switch tv := b.(type) {
+	case BindRule:
+		if matchBKW(tv.Category()) == BindKeyword(0x0) {
+			problems = append(problems,
+				badPTBRuleKeywordErr(tv, `bind`, `bindkeyword`, tv.Category()).Error())
+		} else if err := tv.Valid(); err != nil {
+			problems = append(problems, err.Error())
+		}
+	case BindRules:
+		if tv.Len() == 0 {
+			problems = append(problems, noValueErr(tv, `bind`).Error())
+		}
+		for i := 0; i < tv.Len(); i++ {
+			problems = append(problems, explainBindContext(tv.Index(i))...)
+		}
+	}
+
+	return
+}
+
 /*
 IsZero returns a Boolean value indicative of whether the receiver instance is nil, or unset.
 */
@@ -122,6 +203,56 @@ func (r *permissionBindRule) set(x ...any) {
 	}
 }
 
+/*
+Permission returns the underlying [Permission] instance found within the receiver, else a bogus [Permission] if unset.
+*/
+func (r PermissionBindRule) Permission() (p Permission) {
+	if !r.IsZero() {
+		p = r.permissionBindRule.P
+	}
+	return
+}
+
+/*
+BindRules returns the underlying [BindContext] instance found within the receiver, which may be an instance of [BindRule] or [BindRules]. A bogus [BindRules] instance is returned if the receiver is nil, or if no [BindContext] was set; the return value shall never be nil.
+*/
+func (r PermissionBindRule) BindRules() (b BindContext) {
+	b = badBindRules
+	if !r.IsZero() && r.permissionBindRule.B != nil {
+		b = r.permissionBindRule.B
+	}
+	return
+}
+
+/*
+BindString returns the string representation of the underlying [BindContext] instance found within the receiver, e.g.: `userdn = "ldap:///anyone"`. A zero string is returned if the receiver is nil, or if no [BindContext] was set.
+*/
+func (r PermissionBindRule) BindString() (s string) {
+	if !r.IsZero() && r.permissionBindRule.B != nil {
+		s = r.permissionBindRule.B.String()
+	}
+	return
+}
+
+/*
+PermString returns the string representation of the underlying [Permission] instance found within the receiver, e.g.: `allow(read,search)`. A zero string is returned if the receiver is nil.
+*/
+func (r PermissionBindRule) PermString() (s string) {
+	if !r.IsZero() {
+		s = r.permissionBindRule.P.String()
+	}
+	return
+}
+
+/*
+Disposition returns a Boolean value indicative of whether the underlying [Permission] is granting (allow) in nature. A value of false is returned if the [Permission] is withholding (deny), or if the receiver is nil or invalid.
+
+See the [Permission.Disposition] method for the string equivalent.
+*/
+func (r PermissionBindRule) Disposition() bool {
+	return r.Permission().Disposition() == `allow`
+}
+
 /*
 Kind returns the string literal `pbr`.
 */
@@ -169,9 +300,14 @@ string is a private method called by PermissionBindRule.String.
 func (r PermissionBindRule) string() (s string) {
 	s = badPB
 	if err := r.valid(); err == nil {
-		s = sprintf("%s %s;",
-			r.permissionBindRule.P,
-			r.permissionBindRule.B)
+		buf := getBuffer()
+		defer putBuffer(buf)
+
+		buf.WriteString(r.permissionBindRule.P.String())
+		buf.WriteByte(' ')
+		buf.WriteString(r.permissionBindRule.B.String())
+		buf.WriteByte(';')
+		s = buf.String()
 	}
 
 	return
@@ -221,10 +357,16 @@ func (r PermissionBindRules) Index(idx int) (pbr PermissionBindRule) {
 /*
 String is a stringer method that returns the string representation of the receiver instance.
 
-This method wraps the [stackage.Stack.String] method.
+The output is identical to that of the [stackage.Stack.String] method, though it is assembled more efficiently.
 */
 func (r PermissionBindRules) String() string {
-	return r.cast().String()
+	if r.IsZero() {
+		return ``
+	}
+
+	return assembleList(r.Len(), ' ', func(i int) string {
+		return r.Index(i).String()
+	})
 }
 
 /*
@@ -328,9 +470,12 @@ func (r PermissionBindRules) contains(x any) bool {
 		candidate = tv
 	}
 
+	// render the candidate only once,
+	// rather than once per iteration.
+	cstr := candidate.String()
 	for i := 0; i < r.Len(); i++ {
 		// case is not significant here.
-		if eq(r.Index(i).String(), candidate.String()) {
+		if eq(r.Index(i).String(), cstr) {
 			return true
 		}
 	}
@@ -342,8 +487,12 @@ func (r PermissionBindRules) contains(x any) bool {
 PBRs returns a freshly initialized instance of [PermissionBindRules], configured to store one (1) or more instances of [PermissionBindRule].
 
 Instances of this kind are used as a component in top-level [Instruction] assembly.
+
+A [PaddingOption], created using the [WithPadding] function, may be submitted to override the [RulePadding] and [StackPadding] global variables for the bind contexts of the [PermissionBindRule] instances submitted.
 */
 func PBRs(x ...any) (pbr PermissionBindRules) {
+	x, opt := paddingOption(x)
+
 	// create a native stackage.Stack
 	// and configure before typecast.
 	_p := stackList().
@@ -371,6 +520,10 @@ func PBRs(x ...any) (pbr PermissionBindRules) {
 	// the return instance.
 	_p.Push(x...)
 
+	if opt != nil {
+		applyPadding(pbr, opt.pad)
+	}
+
 	return
 }
 
@@ -402,3 +402,137 @@ func TestPermissionBindRules_codecov(t *testing.T) {
 	pbs.Contains(rule5)
 	pbs.Contains(rule5.String())
 }
+
+func ExampleGrantAll() {
+	rule := UDN(`uid=jesse,ou=People,dc=example,dc=com`).Eq()
+	pbrs := GrantAll(rule,
+		Allow(ReadAccess, SearchAccess),
+		Deny(WriteAccess),
+		Permission{}, // bogus; skipped
+	)
+
+	fmt.Printf("%d: %s", pbrs.Len(), pbrs)
+	// Output: 2: allow(read,search) userdn = "ldap:///uid=jesse,ou=People,dc=example,dc=com"; deny(write) userdn = "ldap:///uid=jesse,ou=People,dc=example,dc=com";
+}
+
+func TestGrantAll(t *testing.T) {
+	if pbrs := GrantAll(nil, Allow(ReadAccess)); pbrs.Len() != 0 {
+		t.Errorf("%s failed: want 0, got %d", t.Name(), pbrs.Len())
+		return
+	}
+
+	if pbrs := GrantAll(BindRule{}, Allow(ReadAccess)); pbrs.Len() != 0 {
+		t.Errorf("%s failed: want 0, got %d", t.Name(), pbrs.Len())
+		return
+	}
+
+	pbrs := GrantAll(Anyone().Eq(), Allow(ReadAccess), Allow(ReadAccess))
+	if pbrs.Len() != 1 {
+		t.Errorf("%s failed: want 1, got %d", t.Name(), pbrs.Len())
+		return
+	}
+
+	for i := 0; i < pbrs.Len(); i++ {
+		if err := pbrs.Index(i).Valid(); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+			return
+		}
+	}
+}
+
+func ExamplePermissionBindRule_Permission() {
+	pbr := PBR(Deny(WriteAccess), Anyone().Eq())
+	fmt.Printf("%s", pbr.Permission())
+	// Output: deny(write)
+}
+
+func ExamplePermissionBindRule_BindRules() {
+	pbr := PBR(Deny(WriteAccess), Anyone().Eq())
+	fmt.Printf("%s", pbr.BindRules())
+	// Output: userdn = "ldap:///anyone"
+}
+
+func ExamplePermissionBindRule_BindString() {
+	pbr := PBR(Allow(ReadAccess, SearchAccess), Or(Anyone().Eq(), Self().Eq()).Paren())
+	fmt.Printf("%s", pbr.BindString())
+	// Output: ( userdn = "ldap:///anyone" OR userdn = "ldap:///self" )
+}
+
+func ExamplePermissionBindRule_PermString() {
+	pbr := PBR(Allow(ReadAccess, SearchAccess), Anyone().Eq())
+	fmt.Printf("%s", pbr.PermString())
+	// Output: allow(read,search)
+}
+
+func ExamplePermissionBindRule_Disposition() {
+	pbr := PBR(Allow(ReadAccess), Anyone().Eq())
+	fmt.Printf("%t", pbr.Disposition())
+	// Output: true
+}
+
+func TestPermissionBindRule_accessors(t *testing.T) {
+	var pbr PermissionBindRule
+	if !pbr.Permission().IsZero() {
+		t.Errorf("%s failed: non-zero %T", t.Name(), Permission{})
+		return
+	}
+
+	if b := pbr.BindRules(); b == nil {
+		t.Errorf("%s failed: nil %T", t.Name(), b)
+		return
+	}
+
+	if pbr.Disposition() {
+		t.Errorf("%s failed: unexpected allow disposition", t.Name())
+		return
+	}
+
+	if pbr.BindString() != `` || pbr.PermString() != `` {
+		t.Errorf("%s failed: non-zero strings for zero %T", t.Name(), pbr)
+		return
+	}
+
+	if pbr = PBR(Deny(ReadAccess), Self().Eq()); pbr.Disposition() {
+		t.Errorf("%s failed: unexpected allow disposition", t.Name())
+		return
+	}
+
+	if want := pbr.PermString() + ` ` + pbr.BindString() + `;`; want != pbr.String() {
+		t.Errorf("%s failed: want %s, got %s", t.Name(), pbr, want)
+		return
+	}
+}
+
+func ExamplePermissionBindRule_Explain() {
+	pbr := PermissionBindRule{&permissionBindRule{
+		P: Permission{},
+		B: And(),
+	}}
+
+	for _, problem := range pbr.Explain() {
+		fmt.Println(problem)
+	}
+	// Output: aci.Permission instance is nil
+	// Found no bind expression during processing of aci.BindRules instance
+}
+
+func TestPermissionBindRule_Explain(t *testing.T) {
+	pbr := PBR(Allow(ReadAccess), Anyone().Eq())
+	if problems := pbr.Explain(); len(problems) != 0 {
+		t.Errorf("%s failed: unexpected problems: %v", t.Name(), problems)
+		return
+	}
+
+	var zero PermissionBindRule
+	for _, bad := range []PermissionBindRule{
+		zero,
+		{&permissionBindRule{P: Allow(ReadAccess)}},
+		{&permissionBindRule{P: Allow(ReadAccess), B: And()}},
+		{&permissionBindRule{B: Anyone().Eq()}},
+	} {
+		if problems := bad.Explain(); len(problems) != 1 {
+			t.Errorf("%s failed: want 1 problem, got %d: %v", t.Name(), len(problems), problems)
+			return
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package aci
+
+/*
+profile.go contains directory product profile types and methods.
+*/
+
+/*
+Profile describes a directory product convention set. Different adopters of the ACIv3 syntax default to different multivalued quotation styles and [AttributeFilterOperations] delimiters; a Profile selects both at once.
+
+See [SetProfile] and the Profile constants for details.
+*/
+type Profile uint8
+
+/*
+Profile constants define the directory product conventions supported by [SetProfile].
+
+  - [ProfileDefault] selects the conventions of this package: [MultivalOuterQuotes] and [AttributeFilterOperationsCommaDelim]
+  - [Profile389DS] selects the 389 Directory Server conventions: [MultivalOuterQuotes] and [AttributeFilterOperationsCommaDelim]
+  - [ProfileNetscape] selects the Netscape Directory Server conventions: [MultivalOuterQuotes] and [AttributeFilterOperationsCommaDelim]
+  - [ProfileOracle] selects the Oracle Unified Directory conventions: [MultivalSliceQuotes] and [AttributeFilterOperationsSemiDelim]
+*/
+const (
+	ProfileDefault  Profile = iota // 0x0, package defaults
+	Profile389DS                   // 0x1, 389 Directory Server
+	ProfileNetscape                // 0x2, Netscape Directory Server
+	ProfileOracle                  // 0x3, Oracle Unified Directory
+)
+
+/*
+MultivalQuoteStyle is a global variable that will be applied to ALL [TargetRule] and [BindRule] instances assembled during package operations. This is a convenient alternative to manually invoking the SetQuoteStyle method on a case-by-case basis.
+
+[MultivalOuterQuotes] is the default, and can be altered here directly, or through the [SetProfile] function.
+
+Note that altering this value will not impact instances that were already created; this only impacts the creation of new instances.
+*/
+var MultivalQuoteStyle int = MultivalOuterQuotes
+
+/*
+AttributeFilterOperationsDelim is a global variable that will be applied to ALL [AttributeFilterOperations] instances assembled during package operations. This is a convenient alternative to manually invoking the [AttributeFilterOperations.SetDelimiter] method on a case-by-case basis.
+
+[AttributeFilterOperationsCommaDelim] is the default, and can be altered here directly, or through the [SetProfile] function.
+
+Note that altering this value will not impact instances that were already created; this only impacts the creation of new instances.
+*/
+var AttributeFilterOperationsDelim int = AttributeFilterOperationsCommaDelim
+
+/*
+SetProfile adjusts the [MultivalQuoteStyle] and [AttributeFilterOperationsDelim] global variables per the conventions of the input [Profile]. See the [Profile] constants for the conventions selected by each.
+
+An unknown [Profile] is ignored.
+
+Note that use of this function will not impact instances that were already created; this only impacts the creation of new instances.
+*/
+func SetProfile(p Profile) {
+	if p.known() {
+		MultivalQuoteStyle = p.quoteStyle()
+		AttributeFilterOperationsDelim = p.delim()
+	}
+}
+
+/*
+known returns a Boolean value indicative of whether the receiver is a recognized [Profile] constant.
+*/
+func (r Profile) known() bool {
+	return r <= ProfileOracle
+}
+
+/*
+quoteStyle returns the multivalued quotation style convention of the receiver.
+*/
+func (r Profile) quoteStyle() int {
+	if r == ProfileOracle {
+		return MultivalSliceQuotes
+	}
+	return MultivalOuterQuotes
+}
+
+/*
+delim returns the [AttributeFilterOperations] delimiter convention of the receiver.
+*/
+func (r Profile) delim() int {
+	if r == ProfileOracle {
+		return AttributeFilterOperationsSemiDelim
+	}
+	return AttributeFilterOperationsCommaDelim
+}
+
+/*
+padded returns a Boolean value indicative of whether the receiver pads comparison operators and parentheticals with spaces, e.g.: `( ssf >= "128" )` as opposed to `(ssf>="128")`.
+*/
+func (r Profile) padded() bool {
+	return r != ProfileOracle
+}
+
+/*
+RenderFor returns the string representation of the receiver as it would be rendered under the conventions of [Profile] p, which govern multivalued quotation style, [AttributeFilterOperations] delimitation and operator/parenthetical spacing. This is useful for comparing an authored [Instruction] against that reported by a particular directory product, such as for drift detection.
+
+  - [ProfileDefault], [Profile389DS] and [ProfileNetscape] use [MultivalOuterQuotes], [AttributeFilterOperationsCommaDelim] and padded operators
+  - [ProfileOracle] uses [MultivalSliceQuotes], [AttributeFilterOperationsSemiDelim] and unpadded operators
+
+The receiver is not modified, nor are any global variables; the rules of the receiver are reassembled through parsing of their string representations, upon which the conventions are imposed. A zero string is returned if the receiver is invalid, if p is unknown, or if reassembly fails.
+*/
+func (r Instruction) RenderFor(p Profile) string {
+	x, ok := r.renderFor(p)
+	if !ok {
+		return ``
+	}
+
+	return x.String()
+}
+
+/*
+renderFor returns a new [Instruction] reassembled from the string representation of the receiver, bearing the conventions of [Profile] p. A Boolean value of false is returned if the receiver is invalid, if p is unknown, or if reassembly fails.
+*/
+func (r Instruction) renderFor(p Profile) (x Instruction, ok bool) {
+	if r.Valid() != nil || !p.known() {
+		return
+	}
+
+	x = ACI(r.ACL())
+	if trs := r.TRs(); trs.Len() > 0 {
+		parsed, err := ParseTargetRules(trs.String())
+		if err != nil {
+			return
+		}
+		for i := 0; i < parsed.Len(); i++ {
+			x.instruction.TRs.Push(profileTargetRule(parsed.Index(i), p))
+		}
+	}
+
+	pbrs := r.PBRs()
+	for i := 0; i < pbrs.Len(); i++ {
+		pbr := pbrs.Index(i)
+		b, err := ParseBindRules(pbr.BindRules().String())
+		if err != nil {
+			return
+		}
+		x.instruction.PBRs.Push(PBR(pbr.Permission(), profileBindContext(b, p)))
+	}
+
+	ok = true
+	return
+}
+
+/*
+profileTargetRule imposes the conventions of [Profile] p upon tr, which is returned.
+*/
+func profileTargetRule(tr TargetRule, p Profile) TargetRule {
+	if afos, ok := tr.Expression().(AttributeFilterOperations); ok {
+		afos.SetDelimiter(p.delim())
+	}
+
+	if multivalued(tr.Expression()) {
+		tr.SetQuoteStyle(p.quoteStyle())
+	}
+
+	return tr.NoPadding(!p.padded())
+}
+
+/*
+profileBindContext imposes the conventions of [Profile] p upon b, recursing through any nested [BindRules] instances. The input value is returned.
+*/
+func profileBindContext(b BindContext, p Profile) BindContext {
+	switch tv := b.(type) {
+	case BindRule:
+		if multivalued(tv.Expression()) {
+			tv.SetQuoteStyle(p.quoteStyle())
+		}
+		tv.NoPadding(!p.padded())
+	case BindRules:
+		tv.NoPadding(!p.padded())
+		for i := 0; i < tv.Len(); i++ {
+			profileBindContext(tv.Index(i), p)
+		}
+	}
+
+	return b
+}
+
+/*
+multivalued returns a Boolean value indicative of whether x is a stack-based expression value bearing two (2) or more values, which is the precondition for a quotation style being imposed.
+*/
+func multivalued(x any) bool {
+	l, ok := x.(interface{ Len() int })
+	return ok && l.Len() > 1
+}
+
+/*
+String returns the string representation of the receiver instance.
+*/
+func (r Profile) String() (s string) {
+	s = `<unknown_profile>`
+	switch r {
+	case ProfileDefault:
+		s = `default`
+	case Profile389DS:
+		s = `389ds`
+	case ProfileNetscape:
+		s = `netscape`
+	case ProfileOracle:
+		s = `oracle`
+	}
+
+	return
+}
@@ -0,0 +1,112 @@
+package aci
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleSetProfile() {
+	SetProfile(ProfileOracle)
+	defer SetProfile(ProfileDefault)
+
+	users := UDNs(
+		`uid=jesse,ou=People,dc=example,dc=com`,
+		`uid=courtney,ou=People,dc=example,dc=com`,
+	)
+	fmt.Println(users.Eq())
+	// Output: userdn = "ldap:///uid=jesse,ou=People,dc=example,dc=com" || "ldap:///uid=courtney,ou=People,dc=example,dc=com"
+}
+
+func ExampleProfile_String() {
+	fmt.Println(Profile389DS)
+	// Output: 389ds
+}
+
+func TestSetProfile(t *testing.T) {
+	defer SetProfile(ProfileDefault)
+
+	users := []string{
+		`uid=jesse,ou=People,dc=example,dc=com`,
+		`uid=courtney,ou=People,dc=example,dc=com`,
+	}
+
+	afos := `add=objectClass:(objectClass=employee), delete=objectClass:(objectClass=contractor)`
+
+	style, delim := MultivalOuterQuotes, `,`
+	for _, p := range []Profile{ProfileDefault, Profile389DS, ProfileNetscape, ProfileOracle, Profile(77)} {
+		// unknown profiles are ignored, thus the
+		// previous (oracle) conventions persist.
+		SetProfile(p)
+		if p == ProfileOracle {
+			style, delim = MultivalSliceQuotes, `;`
+		}
+
+		want := UDNs(users[0], users[1]).Eq().SetQuoteStyle(style).String()
+		if got := UDNs(users[0], users[1]).Eq().String(); got != want {
+			t.Errorf("%s failed [%s]:\nwant: %s\ngot:  %s", t.Name(), p, want, got)
+			return
+		}
+
+		var ops AttributeFilterOperations
+		if err := ops.Parse(afos, AttributeFilterOperationsCommaDelim); err != nil {
+			t.Errorf("%s failed [%s]: %v", t.Name(), p, err)
+			return
+		}
+
+		if got := AFOs(ops.Index(0), ops.Index(1)).String(); !contains(got, delim) {
+			t.Errorf("%s failed [%s]: want '%s' delimiter, got %s", t.Name(), p, delim, got)
+			return
+		}
+	}
+}
+
+func ExampleInstruction_RenderFor() {
+	i := ACI(`Staff reads`,
+		TRs().Push(TAs(`cn`, `sn`).Eq()),
+		PBR(Allow(ReadAccess), GDN(`cn=Staff,ou=Groups,dc=example,dc=com`).Eq()),
+	)
+
+	fmt.Println(i.RenderFor(ProfileOracle))
+	// Output: (targetattr="cn" || "sn")(version 3.0; acl "Staff reads"; allow(read) groupdn="ldap:///cn=Staff,ou=Groups,dc=example,dc=com";)
+}
+
+func TestInstruction_RenderFor(t *testing.T) {
+	i := ACI(`Filtered`,
+		TRs().Push(
+			TAs(`cn`, `sn`).Eq(),
+			AFOs(AddOp.AFO(`cn:(cn=*)`), DelOp.AFO(`sn:(sn=*)`)).Eq(),
+		),
+		PBR(Allow(WriteAccess), And(
+			UDNs(`uid=a,dc=example,dc=com`, `uid=b,dc=example,dc=com`).Eq(),
+			Or(SSF(128).Ge(), SASL.Eq()).Paren(),
+		)),
+	)
+	orig := i.String()
+
+	want := `( targetattr = "cn || sn" )( targattrfilters = "add=cn:(cn=*),delete=sn:(sn=*)" )(version 3.0; acl "Filtered"; allow(write) userdn = "ldap:///uid=a,dc=example,dc=com || ldap:///uid=b,dc=example,dc=com" AND ( ssf >= "128" OR authmethod = "SASL" );)`
+	for _, p := range []Profile{ProfileDefault, Profile389DS, ProfileNetscape} {
+		if got := i.RenderFor(p); got != want {
+			t.Errorf("%s failed [%s]:\nwant: %s\ngot:  %s", t.Name(), p, want, got)
+			return
+		}
+	}
+
+	want = `(targetattr="cn" || "sn")(targattrfilters="add=cn:(cn=*);delete=sn:(sn=*)")(version 3.0; acl "Filtered"; allow(write) userdn="ldap:///uid=a,dc=example,dc=com" || "ldap:///uid=b,dc=example,dc=com" AND (ssf>="128" OR authmethod="SASL");)`
+	if got := i.RenderFor(ProfileOracle); got != want {
+		t.Errorf("%s failed [%s]:\nwant: %s\ngot:  %s", t.Name(), ProfileOracle, want, got)
+		return
+	}
+
+	if i.String() != orig {
+		t.Errorf("%s failed: receiver was modified", t.Name())
+		return
+	} else if got := i.RenderFor(Profile(77)); got != `` {
+		t.Errorf("%s failed: expected zero string for unknown profile, got %s", t.Name(), got)
+		return
+	}
+
+	var zero Instruction
+	if got := zero.RenderFor(ProfileOracle); got != `` {
+		t.Errorf("%s failed: expected zero string for zero %T, got %s", t.Name(), zero, got)
+	}
+}
@@ -0,0 +1,55 @@
+package aci
+
+/*
+recipe.go contains convenience functions that assemble complete [Instruction] instances for commonly encountered access control scenarios.
+*/
+
+/*
+AnonymousReadACI returns a complete [Instruction] named per name, which grants the [ReadAccess], [SearchAccess] and [CompareAccess] rights to all users -- known or anonymous -- for all attributes of all entries within the subtree rooted at baseDN, e.g.:
+
+	( target = "ldap:///ou=People,dc=example,dc=com" )( targetscope = "subtree" )( targetattr = "*" )(version 3.0; acl "Anonymous read"; allow(read,search,compare) userdn = "ldap:///anyone";)
+
+A zero [Instruction] is returned if name is zero length or baseDN is not a valid DN, thus it shall fail the [Instruction.Valid] check. Further [TargetRule] instances (e.g.: a narrower [TargetAttr] list) may be applied by way of the [TargetRules] instance returned by [Instruction.TRs].
+*/
+func AnonymousReadACI(name, baseDN string) (i Instruction) {
+	tdn := TDN(baseDN)
+	if len(name) == 0 || tdn.Valid() != nil {
+		return
+	}
+
+	i = ACI(name,
+		TRs(tdn.Eq(), Subtree.Eq(), TAs(`*`).Eq()),
+		PBR(Allow(ReadAccess, SearchAccess, CompareAccess), Anyone().Eq()),
+	)
+
+	return
+}
+
+/*
+SelfWriteACI returns a complete [Instruction] named per name, which grants the [WriteAccess] right to users for the specified attributes of their own entries, e.g.:
+
+	( targetattr = "telephoneNumber || mobile" )(version 3.0; acl "Self write"; allow(write) userdn = "ldap:///self";)
+
+A zero [Instruction] is returned if name is zero length, if no attrs are provided or if any of attrs is not a valid [AttributeType], thus it shall fail the [Instruction.Valid] check.
+*/
+func SelfWriteACI(name string, attrs ...string) (i Instruction) {
+	if len(name) == 0 || len(attrs) == 0 {
+		return
+	}
+
+	ats := TAs()
+	for _, attr := range attrs {
+		at := AT(attr)
+		if at.IsZero() {
+			return
+		}
+		ats.Push(at)
+	}
+
+	i = ACI(name,
+		TRs(ats.Eq()),
+		PBR(Allow(WriteAccess), Self().Eq()),
+	)
+
+	return
+}
@@ -0,0 +1,76 @@
+package aci
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleAnonymousReadACI() {
+	i := AnonymousReadACI(`Anonymous read`, `ou=People,dc=example,dc=com`)
+	fmt.Printf("%s", i)
+	// Output: ( target = "ldap:///ou=People,dc=example,dc=com" )( targetscope = "subtree" )( targetattr = "*" )(version 3.0; acl "Anonymous read"; allow(read,search,compare) userdn = "ldap:///anyone";)
+}
+
+func TestAnonymousReadACI(t *testing.T) {
+	i := AnonymousReadACI(`Anonymous read`, `ldap:///ou=People,dc=example,dc=com`)
+	if err := i.Valid(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	// make sure the result survives a round-trip
+	var p Instruction
+	if err := p.Parse(i.String()); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if p.String() != i.String() {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), i, p)
+		return
+	}
+
+	for idx, args := range [][2]string{
+		{``, `ou=People,dc=example,dc=com`},
+		{`Anonymous read`, ``},
+		{`Anonymous read`, `ou=People,,dc=example`},
+	} {
+		if bogus := AnonymousReadACI(args[0], args[1]); bogus.Valid() == nil {
+			t.Errorf("%s[%d] failed: expected error, got nil", t.Name(), idx)
+			return
+		}
+	}
+}
+
+func ExampleSelfWriteACI() {
+	i := SelfWriteACI(`Self write`, `telephoneNumber`, `mobile`)
+	fmt.Printf("%s", i)
+	// Output: ( targetattr = "telephoneNumber || mobile" )(version 3.0; acl "Self write"; allow(write) userdn = "ldap:///self";)
+}
+
+func TestSelfWriteACI(t *testing.T) {
+	i := SelfWriteACI(`Self write`, `telephoneNumber`, `mobile`, `jpegPhoto`)
+	if err := i.Valid(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	manual := ACI(`Self write`,
+		TRs(TAs(`telephoneNumber`, `mobile`, `jpegPhoto`).Eq()),
+		PBR(Allow(WriteAccess), UDN(`ldap:///self`).Eq()),
+	)
+
+	if i.String() != manual.String() {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), manual, i)
+		return
+	}
+
+	for idx, bogus := range []Instruction{
+		SelfWriteACI(``, `mobile`),
+		SelfWriteACI(`Self write`),
+		SelfWriteACI(`Self write`, `mobile`, `bogus attr`),
+	} {
+		if bogus.Valid() == nil {
+			t.Errorf("%s[%d] failed: expected error, got nil", t.Name(), idx)
+			return
+		}
+	}
+}
@@ -44,8 +44,25 @@ type Permission struct {
 type permission struct {
 	*bool
 	*rights
+	named uint8 // record of special/explicit right names used during assembly
 }
 
+/*
+permission.named bit flags, used to detect dubious combinations of [Right] instances which are otherwise lost through bit summation.
+*/
+const (
+	namedNone     uint8 = 1 << iota // NoAccess was specified
+	namedAll                        // AllAccess was specified
+	namedExplicit                   // a Right within AllAccess was specified
+)
+
+/*
+DubiousDenyRights contains the [Right] bits regarded as dubious when bestowed upon a withholding (deny) [Permission], as some directory products do not honor them under such a disposition. Such combinations are flagged by [Instruction.ValidStrict].
+
+The default is [SelfWriteAccess], which is subsumed by [WriteAccess] in most implementations. Users may override this value as needed, or set it to [NoAccess] to disable the check entirely.
+*/
+var DubiousDenyRights Right = SelfWriteAccess
+
 /*
 Allow returns a granting [Permission] instance bearing the provided instances of [Right].
 */
@@ -80,15 +97,39 @@ func (r *permission) shift(x ...any) {
 			switch tv := x[i].(type) {
 			case int, Right:
 				r.rights.cast().Shift(tv)
+				r.name(tv)
 			case string:
 				if priv, found := rightsNames[lc(tv)]; found {
 					r.rights.cast().Shift(priv)
+					r.name(priv)
 				}
 			}
 		}
 	}
 }
 
+/*
+name records the use of the input [Right] (or its integer equivalent) within the receiver's named bit flags.
+*/
+func (r *permission) name(x any) {
+	var priv Right
+	switch tv := x.(type) {
+	case int:
+		priv = Right(tv)
+	case Right:
+		priv = tv
+	}
+
+	switch {
+	case priv == NoAccess:
+		r.named |= namedNone
+	case priv == AllAccess:
+		r.named |= namedAll
+	case priv&AllAccess != 0:
+		r.named |= namedExplicit
+	}
+}
+
 func (r *permission) unshift(x ...any) {
 	if !r.isZero() {
 		// iterate through the sequence of "anys"
@@ -235,6 +276,26 @@ func (r permission) disposition() (disp string) {
 	return
 }
 
+/*
+IsAllow returns a Boolean value indicative of whether the receiver is granting (allow) in nature. A value of false is returned if the receiver is withholding (deny), or if it is invalid.
+*/
+func (r Permission) IsAllow() bool {
+	return r.Valid() == nil && *r.permission.bool
+}
+
+/*
+Flip returns a new instance of [Permission] bearing the same [Right] instances as the receiver, but with the opposite disposition: allow becomes deny, and vice versa. The receiver is not modified.
+
+A bogus [Permission] is returned if the receiver is invalid.
+*/
+func (r Permission) Flip() (p Permission) {
+	if r.Valid() == nil {
+		p = Permission{newPermission(!*r.permission.bool, r.bits())}
+	}
+
+	return
+}
+
 /*
 Positive returns a Boolean value indicative of whether a particular bit is positive (is set). Negation implies negative, or unset.
 */
@@ -269,6 +330,80 @@ func (r Permission) Unshift(x ...any) Permission {
 	return r
 }
 
+/*
+Has returns a Boolean value indicative of whether the [Right] named by right is positive within the receiver. Case is not significant. The name `all` is satisfied only if every [Right] described by [AllAccess] is positive, while the name `none` is satisfied only if no [Right] is positive.
+
+A value of false is returned if right is not a recognized [Right] name, or if the receiver is invalid.
+*/
+func (r Permission) Has(right string) (has bool) {
+	priv, found := rightsNames[lc(right)]
+	if !found || r.Valid() != nil {
+		return
+	}
+
+	if priv == NoAccess {
+		has = r.bits() == NoAccess
+	} else {
+		has = r.bits()&priv == priv
+	}
+
+	return
+}
+
+/*
+Add returns a new instance of [Permission] bearing the disposition and [Right] instances of the receiver, as well as those named by rights. Case is not significant. The receiver is not modified.
+
+A bogus [Permission] is returned if the receiver is invalid, or if any of rights is not a recognized [Right] name.
+*/
+func (r Permission) Add(rights ...string) Permission {
+	return r.alter(true, rights...)
+}
+
+/*
+Remove returns a new instance of [Permission] bearing the disposition and [Right] instances of the receiver, minus those named by rights. Case is not significant. The receiver is not modified.
+
+A bogus [Permission] is returned if the receiver is invalid, or if any of rights is not a recognized [Right] name.
+*/
+func (r Permission) Remove(rights ...string) Permission {
+	return r.alter(false, rights...)
+}
+
+/*
+alter is a private method called by the [Permission.Add] and [Permission.Remove] methods.
+*/
+func (r Permission) alter(add bool, rights ...string) (p Permission) {
+	if r.Valid() != nil {
+		return
+	}
+
+	bits := r.bits()
+	for i := 0; i < len(rights); i++ {
+		priv, found := rightsNames[lc(rights[i])]
+		if !found {
+			return
+		}
+
+		if add {
+			bits |= priv
+		} else {
+			bits &^= priv
+		}
+	}
+
+	return Permission{newPermission(*r.permission.bool, bits)}
+}
+
+/*
+bits returns the aggregate [Right] bits of the receiver.
+*/
+func (r Permission) bits() (bits Right) {
+	if !r.IsZero() && r.permission.rights != nil {
+		bits = Right(r.permission.rights.cast().Int())
+	}
+
+	return
+}
+
 /*
 IsZero returns a Boolean value indicative of whether the receiver is nil, or unset.
 */
@@ -288,12 +423,10 @@ func (r *permission) isZero() bool {
 Valid returns a non-error instance if the receiver fails to pass basic validity checks.
 */
 func (r Permission) Valid() (err error) {
-	err = nilInstanceErr(r)
-	if !r.IsZero() {
+	if r.IsZero() {
+		err = nilInstanceErr(r)
+	} else if r.permission.bool == nil {
 		err = noPermissionDispErr()
-		if r.permission.bool != nil {
-			err = nil
-		}
 	}
 
 	return
@@ -300,3 +300,80 @@ func TestPermission_codecov(t *testing.T) {
 	p.permission = new(permission)
 	_ = p.Valid()
 }
+
+func ExamplePermission_Add() {
+	perm := Allow(ReadAccess, WriteAccess)
+	fmt.Println(perm.Add(`compare`).Remove(`write`))
+	// Output: allow(read,compare)
+}
+
+func ExamplePermission_Has() {
+	perm := Deny(WriteAccess, DeleteAccess)
+	fmt.Println(perm.Has(`write`), perm.Has(`READ`))
+	// Output: true false
+}
+
+func TestPermission_setOperations(t *testing.T) {
+	perm := Allow(ReadAccess, SearchAccess)
+
+	added := perm.Add(`compare`, `Write`)
+	if got, want := added.String(), `allow(read,write,search,compare)`; got != want {
+		t.Errorf("%s failed: want %s, got %s", t.Name(), want, got)
+		return
+	} else if got, want = perm.String(), `allow(read,search)`; got != want {
+		t.Errorf("%s failed: receiver was modified: %s", t.Name(), got)
+		return
+	}
+
+	if all := Deny(ReadAccess).Add(`all`); !all.Has(`all`) || all.Disposition() != `deny` {
+		t.Errorf("%s failed: unexpected result: %s", t.Name(), all)
+		return
+	} else if none := all.Remove(`all`); !none.Has(`none`) || none.Has(`read`) || none.Disposition() != `deny` {
+		t.Errorf("%s failed: unexpected result: %s", t.Name(), none)
+		return
+	}
+
+	if bogus := perm.Add(`read`, `frobnicate`); !bogus.IsZero() {
+		t.Errorf("%s failed: expected bogus %T, got %s", t.Name(), bogus, bogus)
+		return
+	} else if perm.Has(`frobnicate`) || perm.Has(`all`) || perm.Has(`none`) {
+		t.Errorf("%s failed: unexpected positive Has result", t.Name())
+		return
+	}
+
+	var zero Permission
+	if zero.Has(`read`) || !zero.Add(`read`).IsZero() || !zero.Remove(`read`).IsZero() {
+		t.Errorf("%s failed: unexpected result for zero %T", t.Name(), zero)
+	}
+}
+
+func ExamplePermission_Flip() {
+	perm := Allow(ReadAccess, SearchAccess, CompareAccess)
+	fmt.Println(perm.Flip(), perm.Flip().IsAllow())
+	// Output: deny(read,search,compare) false
+}
+
+func TestPermission_Flip(t *testing.T) {
+	for idx, perm := range []Permission{
+		Allow(AllAccess, ProxyAccess),
+		Deny(NoAccess),
+		Deny(WriteAccess),
+	} {
+		flipped := perm.Flip()
+		if flipped.IsAllow() == perm.IsAllow() {
+			t.Errorf("%s[%d] failed: disposition not flipped: %s", t.Name(), idx, flipped)
+			return
+		} else if flipped.bits() != perm.bits() {
+			t.Errorf("%s[%d] failed: rights altered: %s vs %s", t.Name(), idx, perm, flipped)
+			return
+		} else if flipped.Flip().String() != perm.String() {
+			t.Errorf("%s[%d] failed: double flip mismatch: %s", t.Name(), idx, flipped.Flip())
+			return
+		}
+	}
+
+	var zero Permission
+	if !zero.Flip().IsZero() || zero.IsAllow() {
+		t.Errorf("%s failed: unexpected result for zero %T", t.Name(), zero)
+	}
+}
@@ -16,8 +16,10 @@ type SearchScope uint8
 /*
 Scope initializes, sets and returns an instance of SearchScope in one shot. Valid input types are as follows:
 
-  - Standard scope names as string values (e.g.: `base`, `sub`, `subtree` and others)
-  - Integer representations of scopes (see the predefined [SearchScope] constants for details)
+  - Standard scope names as string values (e.g.: `base`, `onelevel`, `subtree` and `subordinate`, as well as the abbreviated forms `one` and `sub`); case is not significant
+  - Integer codes per RFC 4511 Section 4.5.1.2 (0 for `base`, 1 for `onelevel`, 2 for `subtree`), as well as 3 for `subordinate`
+
+Unknown or unsupported input values result in a zero [SearchScope] instance, the Eq method of which shall return a bogus [TargetRule].
 
 This function may only be needed in certain situations where a scope needs to be parsed from values with different representations. Usually the predefined [SearchScope] constants are sufficient.
 */
@@ -124,6 +126,15 @@ func (r SearchScope) TRM() TargetRuleMethods {
 	})
 }
 
+/*
+Rule returns a [TargetRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [TargetRule] is returned if op is not permitted for use with the receiver.
+
+See also the [SearchScope.TRM] method.
+*/
+func (r SearchScope) Rule(op any) TargetRule {
+	return r.TRM().rule(op)
+}
+
 /*
 String is a stringer method that returns the string representation of the receiver.  In this particular case, the more succinct and standard string variant is returned, e.g.: `one` for [SingleLevel]. This will normally be used within [LDAPURI] instances.
 
@@ -140,6 +151,23 @@ func (r SearchScope) Compare(x any) bool {
 	return compareHashInstance(r, x)
 }
 
+/*
+IsZero returns a Boolean value indicative of whether the receiver is unset, or does not describe a known [SearchScope] constant. This condition coincides with a zero string return by [SearchScope.String].
+*/
+func (r SearchScope) IsZero() bool {
+	return len(r.targetScope()) == 0
+}
+
+/*
+Len returns one (1) if the receiver describes a known [SearchScope] constant, else zero (0). This method exists primarily for consistency with the other expression value types defined in this package.
+*/
+func (r SearchScope) Len() int {
+	if r.IsZero() {
+		return 0
+	}
+	return 1
+}
+
 /*
 Target is a stringer method that returns the string representation of the receiver.
 
@@ -150,11 +178,11 @@ func (r SearchScope) Target() string {
 }
 
 /*
-strToScope returns a SearchScope constant based on the string input. If a match does not occur, BaseObject (default) is returned.
+strToScope returns a SearchScope constant based on the string input. If a match does not occur, noScope is returned.
 */
 func strToScope(x string) (s SearchScope) {
 	s = noScope
-	switch lc(x) {
+	switch lc(trimS(x)) {
 	case `base`, `baseobject`:
 		s = BaseObject
 	case `one`, `onelevel`:
@@ -169,11 +197,13 @@ func strToScope(x string) (s SearchScope) {
 }
 
 /*
-intToScope returns a SearchScope constant based on the integer input. If a match does not occur, BaseObject (default) is returned.
+intToScope returns a SearchScope constant based on the integer input. If a match does not occur, noScope is returned.
 */
 func intToScope(x int) (s SearchScope) {
-	s = BaseObject //default
+	s = noScope
 	switch x {
+	case 0:
+		s = BaseObject
 	case 1:
 		s = SingleLevel
 	case 2:
@@ -100,3 +100,52 @@ func ExampleSearchScope_Ne() {
 	fmt.Printf("%s", SingleLevel.Ne()) // ILLEGAL!!!!
 	// Output:
 }
+
+func ExampleScope() {
+	fmt.Println(Scope(`SubTree`).Eq())
+	fmt.Println(Scope(1).Eq())
+	fmt.Println(Scope(`bogus`).Eq().IsZero(), Scope(7).Eq().IsZero())
+	// Output: ( targetscope = "subtree" )
+	// ( targetscope = "onelevel" )
+	// true true
+}
+
+func TestScope_unknown(t *testing.T) {
+	for _, bogus := range []any{`children_of`, ``, -1, 4, 3.0, nil} {
+		if sc := Scope(bogus); sc != noScope {
+			t.Errorf("%s failed; unexpected %T for '%v': %s",
+				t.Name(), sc, bogus, sc)
+			return
+		}
+	}
+}
+
+func ExampleSubordinate() {
+	fmt.Println(Subordinate.Eq())
+	// Output: ( targetscope = "subordinate" )
+}
+
+func TestSubordinate_roundTrip(t *testing.T) {
+	want := Subordinate.Eq().String()
+
+	tr, err := ParseTargetRule(want)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if got := tr.String(); got != want {
+		t.Errorf("%s failed:\nwant: %s\ngot:  %s", t.Name(), want, got)
+		return
+	}
+
+	if Scope(Subordinate.String()) != Subordinate || Scope(3) != Subordinate {
+		t.Errorf("%s failed: %s did not survive Scope", t.Name(), Subordinate)
+		return
+	}
+
+	for _, cop := range []ComparisonOperator{Ne, Lt, Le, Gt, Ge} {
+		if keywordAllowsComparisonOperator(TargetScope, cop) {
+			t.Errorf("%s failed: %s permitted for %s", t.Name(), cop.Context(), TargetScope)
+			return
+		}
+	}
+}
@@ -55,6 +55,15 @@ func (r AuthenticationMethod) BRM() BindRuleMethods {
 	})
 }
 
+/*
+Rule returns a [BindRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [BindRule] is returned if op is not permitted for use with the receiver.
+
+See also the [AuthenticationMethod.BRM] method.
+*/
+func (r AuthenticationMethod) Rule(op any) BindRule {
+	return r.BRM().rule(op)
+}
+
 /*
 Eq initializes and returns a new [BindRule] instance configured to express the evaluation of the receiver value as Equal-To the [BindAM] [BindKeyword] context.
 */
@@ -98,6 +107,23 @@ func (r AuthenticationMethod) Compare(x any) bool {
 	return compareHashInstance(r, x)
 }
 
+/*
+IsZero returns a Boolean value indicative of whether the receiver is unset, or does not describe a known [AuthenticationMethod] constant. This condition coincides with a zero string return by [AuthenticationMethod.String].
+*/
+func (r AuthenticationMethod) IsZero() bool {
+	return len(r.String()) == 0
+}
+
+/*
+Len returns one (1) if the receiver describes a known [AuthenticationMethod] constant, else zero (0). This method exists primarily for consistency with the other expression value types defined in this package.
+*/
+func (r AuthenticationMethod) Len() int {
+	if r.IsZero() {
+		return 0
+	}
+	return 1
+}
+
 /*
 SecurityStrengthFactor embeds a pointer to uint8. A nil uint8 value indicates an effective security strength factor of zero (0). A non-nil uint8 value expresses uint8 + 1, thereby allowing a range of 0-256 "within" a uint8 instance.
 */
@@ -138,6 +164,16 @@ func (r SecurityStrengthFactor) IsZero() bool {
 	return r.uint8 == nil
 }
 
+/*
+Len returns one (1) if the receiver is set, else zero (0). This method exists primarily for consistency with the other expression value types defined in this package.
+*/
+func (r SecurityStrengthFactor) Len() int {
+	if r.IsZero() {
+		return 0
+	}
+	return 1
+}
+
 /*
 Eq initializes and returns a new [BindRule] instance configured to express the evaluation of the receiver value as Equal-To the [BindSSF] [BindKeyword] context.
 */
@@ -202,6 +238,15 @@ func (r SecurityStrengthFactor) BRM() BindRuleMethods {
 	})
 }
 
+/*
+Rule returns a [BindRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [BindRule] is returned if op is not permitted for use with the receiver.
+
+See also the [SecurityStrengthFactor.BRM] method.
+*/
+func (r SecurityStrengthFactor) Rule(op any) BindRule {
+	return r.BRM().rule(op)
+}
+
 /*
 String is a stringer method that returns the string representation of the receiver instance.
 */
@@ -288,6 +333,42 @@ func (r *ssf) set(factor any) {
 	return
 }
 
+/*
+ssfExpressionInt returns the integer security strength factor described by x, which may be a [SecurityStrengthFactor], int or string value (such as those assigned to a [BindSSF] [BindRule] through use of the [BR] function), alongside a Boolean value indicative of whether x was understood. No range checks are performed.
+*/
+func ssfExpressionInt(x any) (i int, ok bool) {
+	switch tv := x.(type) {
+	case SecurityStrengthFactor:
+		if ok = true; !tv.IsZero() {
+			i = int(*tv.ssf.uint8) + 1
+		}
+	case int:
+		i, ok = tv, true
+	case string:
+		switch lc(tv) {
+		case `full`, `max`, `none`, `off`:
+			i, ok = stringToIntSSF(tv), true
+		default:
+			var err error
+			i, err = atoi(tv)
+			ok = err == nil
+		}
+	}
+
+	return
+}
+
+/*
+validSSFExpression returns an error if x does not describe a security strength factor within the range of zero (0) through 256, inclusive. See [ssfExpressionInt] for the types accepted.
+*/
+func validSSFExpression(x any) (err error) {
+	if i, ok := ssfExpressionInt(x); !ok || i < 0 || i > 256 {
+		err = badSecurityStrengthFactorRangeErr(x)
+	}
+
+	return
+}
+
 func stringToIntSSF(x string) (i int) {
 	switch lc(x) {
 	case `full`, `max`:
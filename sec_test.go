@@ -237,3 +237,28 @@ func ExampleSecurityStrengthFactor_Compare() {
 	fmt.Printf("Hashes are equal: %t", ssf1.Compare(ssf2))
 	// Output: Hashes are equal: true
 }
+
+func TestSecurityStrengthFactor_ruleRange(t *testing.T) {
+	for idx, tc := range []struct {
+		br    BindRule
+		valid bool
+	}{
+		{SSF(300).Ge(), true}, // clamped to 256
+		{BR(BindSSF, Ge, 256), true},
+		{BR(BindSSF, Ge, `max`), true},
+		{BR(BindSSF, Ge, `0`), true},
+		{BR(BindSSF, Ge, 300), false},
+		{BR(BindSSF, Ge, `300`), false},
+		{BR(BindSSF, Lt, -1), false},
+		{BR(BindSSF, Ge, `strong`), false},
+	} {
+		if err := tc.br.Valid(); (err == nil) != tc.valid {
+			t.Errorf("%s[%d] failed: want valid:%t, got %v (%s)", t.Name(), idx, tc.valid, err, tc.br)
+			return
+		}
+	}
+
+	if got := SSF(300).Ge().String(); got != `ssf >= "256"` {
+		t.Errorf("%s failed: want %s, got %s", t.Name(), `ssf >= "256"`, got)
+	}
+}
@@ -5,6 +5,8 @@ stackage is a bridge to the go-stackage package
 */
 
 import (
+	"sort"
+
 	"github.com/JesseCoretta/go-stackage"
 )
 
@@ -154,6 +156,39 @@ type (
 	AttributeFilterOperations stackage.Stack
 )
 
+/*
+Rule is a convenient interface type that is qualified by the following types:
+
+  - [TargetRule]
+  - [BindRule]
+
+The qualifying methods shown below allow a single [stackage.Condition]-based rule of either kind to be handled by generic tooling without type assertion. Unlike [BindContext], this interface is never qualified by a stack type.
+*/
+type Rule interface {
+	// Keyword returns the TargetKeyword or BindKeyword
+	// of the receiver, enveloped as a Keyword.
+	Keyword() Keyword
+
+	// Operator returns the ComparisonOperator of the
+	// receiver.
+	Operator() ComparisonOperator
+
+	// Expression returns the expression value of the
+	// receiver, which must be type asserted as needed.
+	Expression() any
+
+	// String returns the string representation of the
+	// receiver instance.
+	String() string
+
+	// Valid returns an error if the receiver is in an
+	// aberrant state.
+	Valid() error
+
+	// Kind returns the string literal `condition`.
+	Kind() string
+}
+
 /*
 castAsCondition merely wraps (casts, converts) and returns an
 instance of BindRule -OR- TargetRule as a [stackage.Condition]
@@ -171,7 +206,6 @@ been altered to one's satisfaction, can be sent off as intended and
 this "Condition Counterpart" can be discarded, or left for GC.
 */
 func castAsCondition(x any) (c stackage.Condition) {
-	c = badCond(errorf("Unsupported cast type %T for %T", x, c))
 	switch tv := x.(type) {
 
 	// case match is a single BindRule instance
@@ -181,6 +215,9 @@ func castAsCondition(x any) (c stackage.Condition) {
 	// case match is a single TargetRule instance
 	case TargetRule:
 		c = stackage.Condition(tv)
+
+	default:
+		c = badCond(errorf("Unsupported cast type %T for %T", x, c))
 	}
 
 	return
@@ -214,6 +251,26 @@ func (r TargetRule) cast() stackage.Condition {
 	return castAsCondition(r)
 }
 
+/*
+copyCondition returns a new [stackage.Condition] bearing the keyword, operator, ID, category, parenthetical, padding and value encapsulation states of src. The expression value is shared, not copied.
+*/
+func copyCondition(src stackage.Condition) (c stackage.Condition) {
+	c.Init()
+	c.SetKeyword(src.Keyword()).
+		SetOperator(src.Operator()).
+		SetExpression(src.Expression()).
+		SetID(src.ID()).
+		SetCategory(src.Category()).
+		Paren(src.IsParen()).
+		NoPadding(!src.IsPadded())
+
+	if src.IsEncap() {
+		c.Encap(`"`)
+	}
+
+	return
+}
+
 /*
 cast is a private convenience method intended to streamline
 the act of casting a TargetRules instance to a stackage.Stack
@@ -288,10 +345,13 @@ func castAsCop(x ComparisonOperator) stackage.ComparisonOperator {
 	return stackage.ComparisonOperator(x)
 }
 
+/*
+castCop returns the [ComparisonOperator] form of x, which may be a native [stackage.ComparisonOperator] instance (as is the case for rules produced through parsing) or an instance of [ComparisonOperator]. A bogus [ComparisonOperator] is returned for any other input.
+*/
 func castCop(x any) (cop ComparisonOperator) {
-	//case stackage.ComparisonOperator:
-	//	cop = ComparisonOperator(tv)
 	switch tv := x.(type) {
+	case stackage.ComparisonOperator:
+		cop = ComparisonOperator(tv)
 	case ComparisonOperator:
 		cop = tv
 	}
@@ -453,6 +513,45 @@ func castFilterRules(x any) (S stackage.Stack, converted bool) {
 	return
 }
 
+/*
+setMultivalDelim is a private function called by the SetDelimiter methods extended by the [AttributeTypes] and [ObjectIdentifiers] types.
+*/
+func setMultivalDelim(stk stackage.Stack, i ...int) {
+	// only the symbolic OR operator (default)
+	// and the comma are permitted, and only
+	// for non-list (i.e.: non-URI) contexts.
+	if eq(stk.Kind(), `list`) {
+		return
+	}
+
+	if len(i) > 0 && i[0] == MultivalCommaDelim {
+		stk.Symbol(`,`).NoPadding(true)
+	} else {
+		stk.Symbol(`||`).NoPadding(!StackPadding)
+	}
+}
+
+/*
+sortStack is a private function called by the Sort methods extended by the [AttributeTypes], [ObjectIdentifiers], [BindDistinguishedNames] and [TargetDistinguishedNames] types. The slices of stk are reordered in place per the less closure; the sort is stable.
+*/
+func sortStack(stk stackage.Stack, less func(a, b any) bool) {
+	slices := make([]any, stk.Len())
+	for i := 0; i < len(slices); i++ {
+		slices[i], _ = stk.Index(i)
+	}
+
+	sort.SliceStable(slices, func(i, j int) bool {
+		return less(slices[i], slices[j])
+	})
+
+	for i := 0; i < len(slices); i++ {
+		stk.Replace(slices[i], i)
+	}
+}
+
+/*
+badCond returns a bogus stackage.Condition instance bearing the input error.
+*/
 func badCond(err error) (bad stackage.Condition) {
 	bad.Init()
 	bad.SetErr(err)
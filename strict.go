@@ -0,0 +1,532 @@
+package aci
+
+/*
+strict.go contains heuristic (strict) validation methods for use in ACI authoring and review.
+*/
+
+import (
+	"errors"
+)
+
+/*
+ValidStrict returns an error if the receiver fails the checks of [Instruction.Valid], or if any of the heuristic checks described below flag a likely authoring mistake. Unlike those of [Instruction.Valid], such findings do not necessarily render the receiver unusable; they merely warrant review. All findings are joined into a single error through use of [errors.Join].
+
+Template tokens:
+
+  - Any `{{key}}` template token remaining within the ACL label or a distinguished name expression (see [Instruction.Tokens]) is unexpanded, and likely the result of an incomplete call of [Instruction.Expand]
+
+[TargetScope] (targetscope) and [Target] (target) DN pattern interplay:
+
+  - A [SingleLevel] (onelevel) scope alongside a target DN pattern that bears wildcards (*) within more than one (1) RDN is contradictory, as such a pattern spans entries at varying depths while the scope permits only the immediate children of each match
+  - A [Subordinate] scope alongside a target DN pattern whose leftmost RDN value is a lone wildcard (e.g.: "uid=*,ou=People,...") is contradictory, as such patterns generally describe leaf entries, which have no subordinates
+  - A [Subtree] scope alongside such a "leaf wildcard" pattern is redundant, as the scope contributes nothing beyond the matched entries themselves
+
+Only equality-based [Target] and [TargetScope] rules are considered.
+
+[Permission] right/disposition combinations:
+
+  - [NoAccess] (none) specified alongside any other [Right] is contradictory, regardless of disposition
+  - [AllAccess] (all) specified alongside any [Right] that it already includes is redundant, regardless of disposition
+  - A withholding (deny) [Permission] bearing any of the [Right] bits within the [DubiousDenyRights] global variable is dubious
+
+Note that the first two (2) checks apply only to [Permission] instances assembled from individual [Right] names or values (including those produced through parsing), as bit summation otherwise renders such combinations indistinguishable.
+
+[TargetAttr] (targetattr) wildcard usage:
+
+  - An [AttributeTypes] list that combines the wildcard (*) with specific names (e.g.: "* || aci") is product-specific, being honored by Netscape-derived products such as 389 Directory Server, but not necessarily elsewhere
+
+[BindToD] (timeofday) operator usage:
+
+  - A timeofday [BindRule] bearing the [Eq] or [Ne] [ComparisonOperator] is dubious, as it matches (or excludes) but a single minute of the day; a range (e.g.: [Timeframe]) bearing the [Ge] and [Le] operators is almost always intended. This check is suppressed when the [StrictTimeOfDayEquality] global variable is false
+
+[BindSSF] (ssf) thresholds:
+
+  - An ssf [BindRule] bearing the [Gt] [ComparisonOperator] alongside the maximum factor of 256, or the [Lt] [ComparisonOperator] alongside zero (0), is impossible, as it can never be satisfied
+
+[BindRules] complexity:
+
+  - A bind context whose nesting depth (see [BindRules.Depth]) exceeds the [MaxBindRuleDepth] global variable is excessive; this check is disabled when said variable is zero (0) or less
+
+[BindRules] sibling conditions:
+
+  - Two (2) or more [BindRule] members of the same Boolean stack bearing an identical keyword, operator and value are redundant, regardless of the stack's Boolean nature
+  - Within an AND stack, [BindSSF] (ssf) or [BindToD] (timeofday) members whose combined bounds admit no value (e.g.: "ssf >= 128 AND ssf < 64", or two timeofday [Eq] members bearing differing values) are contradictory
+  - Within an AND stack, members bearing the same keyword and value alongside the [Eq] and [Ne] operators respectively are contradictory
+  - Within an AND stack, [BindAM] (authmethod) [Eq] members bearing differing values are contradictory, as a connection is authenticated by but one (1) method
+
+The members of nested stacks bearing the same Boolean nature as their parent (e.g.: an AND stack within an AND stack) are compared as siblings of the parent's members, as such nesting does not alter their meaning. Conditions within nested stacks of any other nature, including negated (NOT) stacks, are compared only with their own siblings. The default [Instruction.Valid] method performs none of these checks.
+*/
+func (r Instruction) ValidStrict() (err error) {
+	if err = r.Valid(); err != nil {
+		return
+	}
+
+	var errs []error
+	if tokens := r.Tokens(); len(tokens) > 0 {
+		errs = append(errs, unexpandedTokensErr(tokens))
+	}
+
+	errs = append(errs, strictTargetScope(r)...)
+	errs = append(errs, strictRights(r)...)
+	errs = append(errs, strictDepth(r)...)
+	errs = append(errs, strictAttrWildcard(r)...)
+	errs = append(errs, strictTimeOfDay(r)...)
+	errs = append(errs, strictSSF(r)...)
+	errs = append(errs, strictSiblings(r)...)
+
+	return errors.Join(errs...)
+}
+
+/*
+MaxBindRuleDepth is a global variable that defines the maximum nesting depth, as reported by [BindRules.Depth], permitted for the bind context of any [PermissionBindRule] evaluated by [Instruction.ValidStrict]. Some directory products limit expression nesting, thus this allows such a cap to be enforced prior to deployment.
+
+A value of zero (0), the default, disables the check.
+*/
+var MaxBindRuleDepth int
+
+/*
+strictDepth returns slices of error describing any [PermissionBindRule] bind context within the input [Instruction] whose nesting depth exceeds [MaxBindRuleDepth].
+*/
+func strictDepth(i Instruction) (errs []error) {
+	if MaxBindRuleDepth <= 0 {
+		return
+	}
+
+	pbrs := i.PBRs()
+	for j := 0; j < pbrs.Len(); j++ {
+		pbr := pbrs.Index(j)
+		if depth := bindContextDepth(pbr.BindRules()); depth > MaxBindRuleDepth {
+			errs = append(errs, strictDepthErr(pbr.Permission(), depth))
+		}
+	}
+
+	return
+}
+
+/*
+StrictTimeOfDayEquality is a global variable that controls whether [Instruction.ValidStrict] flags [BindToD] (timeofday) [BindRule] instances bearing the [Eq] or [Ne] [ComparisonOperator]. Set to false to suppress this check in environments where exact-minute matching is intended.
+*/
+var StrictTimeOfDayEquality bool = true
+
+/*
+strictTimeOfDay returns slices of error describing any equality-based [BindToD] [BindRule] found within the bind contexts of the input [Instruction].
+*/
+func strictTimeOfDay(i Instruction) (errs []error) {
+	if !StrictTimeOfDayEquality {
+		return
+	}
+
+	pbrs := i.PBRs()
+	for j := 0; j < pbrs.Len(); j++ {
+		for _, br := range bindRulesOf(pbrs.Index(j).BindRules()) {
+			if br.Keyword() != BindToD {
+				continue
+			}
+
+			if op := br.Operator(); op == Eq || op == Ne {
+				errs = append(errs, strictTimeOfDayErr(br))
+			}
+		}
+	}
+
+	return
+}
+
+/*
+strictSSF returns slices of error describing any [BindSSF] [BindRule] found within the bind contexts of the input [Instruction] that can never be satisfied.
+*/
+func strictSSF(i Instruction) (errs []error) {
+	pbrs := i.PBRs()
+	for j := 0; j < pbrs.Len(); j++ {
+		for _, br := range bindRulesOf(pbrs.Index(j).BindRules()) {
+			if br.Keyword() != BindSSF {
+				continue
+			}
+
+			factor, _ := ssfExpressionInt(br.Expression())
+			if op := br.Operator(); (op == Gt && factor >= 256) || (op == Lt && factor <= 0) {
+				errs = append(errs, strictSSFErr(br))
+			}
+		}
+	}
+
+	return
+}
+
+/*
+strictAttrWildcard returns slices of error describing any [TargetAttr] rule within the input [Instruction] whose [AttributeTypes] combine the wildcard (*) with specific names.
+*/
+func strictAttrWildcard(i Instruction) (errs []error) {
+	trs := i.TRs()
+	for j := 0; j < trs.Len(); j++ {
+		tr := trs.Index(j)
+		if tr.Keyword() != TargetAttr {
+			continue
+		}
+
+		if ats, ok := tr.Expression().(AttributeTypes); ok && ats.wildcardPlus() {
+			errs = append(errs, strictAttrWildcardErr(ats))
+		}
+	}
+
+	return
+}
+
+/*
+strictSiblings returns slices of error describing any redundant or contradictory sibling [BindRule] members found within any [BindRules] stack of the bind contexts of the input [Instruction].
+*/
+func strictSiblings(i Instruction) (errs []error) {
+	pbrs := i.PBRs()
+	for j := 0; j < pbrs.Len(); j++ {
+		errs = append(errs, bindSiblings(pbrs.Index(j).BindRules())...)
+	}
+
+	return
+}
+
+/*
+bindSiblings is the recursive backend of strictSiblings.
+*/
+func bindSiblings(b BindContext) (errs []error) {
+	stack, ok := b.(BindRules)
+	if !ok {
+		return
+	}
+
+	leaves, others := flattenGroup(stack)
+	for _, other := range others {
+		errs = append(errs, bindSiblings(other)...)
+	}
+
+	seen := make(map[string]bool)
+	for _, br := range leaves {
+		key := lc(sprintf("%s %s %s", br.Keyword(), br.Operator(), br.Expression()))
+		if seen[key] {
+			errs = append(errs, strictSiblingsErr(`redundant`, br.Keyword(), stack))
+		}
+		seen[key] = true
+	}
+
+	if eq(stack.Category(), `and`) {
+		errs = append(errs, contradictorySiblings(stack, leaves)...)
+	}
+
+	return
+}
+
+/*
+flattenGroup returns the [BindRule] members of stack, including those of any nested AND or OR stack bearing the same Boolean nature as stack, as such nesting -- whether parenthetical or an artifact of parsing -- does not alter the meaning of the whole. Nested stacks of any other nature are returned separately.
+*/
+func flattenGroup(stack BindRules) (leaves []BindRule, others []BindRules) {
+	cat := lc(stack.Category())
+	for i := 0; i < stack.Len(); i++ {
+		switch tv := stack.Index(i).(type) {
+		case BindRule:
+			leaves = append(leaves, tv)
+		case BindRules:
+			if cat != `not` && eq(tv.Category(), cat) {
+				l, o := flattenGroup(tv)
+				leaves, others = append(leaves, l...), append(others, o...)
+			} else {
+				others = append(others, tv)
+			}
+		}
+	}
+
+	return
+}
+
+/*
+contradictorySiblings returns slices of error describing each [BindKeyword] for which the AND-joined leaves of stack can never be satisfied together.
+*/
+func contradictorySiblings(stack BindRules, leaves []BindRule) (errs []error) {
+	var kws []BindKeyword
+	byKW := make(map[BindKeyword][]BindRule)
+	for _, br := range leaves {
+		kw, _ := br.Keyword().(BindKeyword)
+		if _, found := byKW[kw]; !found {
+			kws = append(kws, kw)
+		}
+		byKW[kw] = append(byKW[kw], br)
+	}
+
+	for _, kw := range kws {
+		if unsatisfiable(kw, byKW[kw]) {
+			errs = append(errs, strictSiblingsErr(`contradictory`, kw, stack))
+		}
+	}
+
+	return
+}
+
+/*
+unsatisfiable returns a Boolean value indicative of whether the AND-joined [BindRule] instances within brs, each of which bears [BindKeyword] kw, can never be satisfied together.
+*/
+func unsatisfiable(kw BindKeyword, brs []BindRule) bool {
+	if kw == BindSSF || kw == BindToD {
+		return emptyBounds(brs)
+	}
+
+	eqs := make(map[string]bool)
+	nes := make(map[string]bool)
+	for _, br := range brs {
+		value := lc(sprintf("%s", br.Expression()))
+		switch br.Operator() {
+		case Eq:
+			eqs[value] = true
+		case Ne:
+			nes[value] = true
+		}
+	}
+
+	for value := range eqs {
+		if nes[value] {
+			return true
+		}
+	}
+
+	return kw == BindAM && len(eqs) > 1
+}
+
+/*
+emptyBounds returns a Boolean value indicative of whether the combined numerical bounds of the AND-joined [BindSSF] or [BindToD] [BindRule] instances within brs admit no value. Instances whose expression is not numerical are ignored.
+*/
+func emptyBounds(brs []BindRule) bool {
+	lo, hi := -1<<31, 1<<31-1
+	excluded := make(map[int]bool)
+	for _, br := range brs {
+		n, err := atoi(sprintf("%s", br.Expression()))
+		if err != nil {
+			continue
+		}
+
+		// derive the inclusive floor and ceiling
+		// imposed by this condition, if any.
+		floor, ceil := lo, hi
+		switch br.Operator() {
+		case Eq:
+			floor, ceil = n, n
+		case Ne:
+			excluded[n] = true
+		case Lt:
+			ceil = n - 1
+		case Le:
+			ceil = n
+		case Gt:
+			floor = n + 1
+		case Ge:
+			floor = n
+		}
+
+		if floor > lo {
+			lo = floor
+		}
+		if ceil < hi {
+			hi = ceil
+		}
+	}
+
+	return lo > hi || (lo == hi && excluded[lo])
+}
+
+/*
+strictRights returns slices of error describing any dubious [Right] and disposition combinations found within the [PermissionBindRule] instances of the input [Instruction].
+*/
+func strictRights(i Instruction) (errs []error) {
+	pbrs := i.PBRs()
+	for j := 0; j < pbrs.Len(); j++ {
+		perm := pbrs.Index(j).Permission()
+		if perm.Valid() != nil {
+			continue
+		}
+
+		named := perm.permission.named
+		if named&namedNone != 0 && named != namedNone {
+			errs = append(errs, strictRightsErr(perm, `contradictory`, NoAccess))
+		}
+
+		if named&namedAll != 0 && named&namedExplicit != 0 {
+			errs = append(errs, strictRightsErr(perm, `redundant`, AllAccess))
+		}
+
+		if dubious := perm.bits() & DubiousDenyRights; !perm.IsAllow() && dubious != 0 {
+			errs = append(errs, strictRightsErr(perm, `dubious`, dubious))
+		}
+	}
+
+	return
+}
+
+/*
+strictTargetScope returns slices of error describing any redundant or contradictory pairings of the [TargetScope] rule and [Target] DN patterns of the input [Instruction].
+*/
+func strictTargetScope(i Instruction) (errs []error) {
+	scope := instructionScope(i)
+	if scope == noScope || scope == BaseObject {
+		return
+	}
+
+	for _, dn := range i.targetDNs() {
+		rdns := splitDN(chopDNPfx(dn))
+		switch {
+		case scope == SingleLevel && wildcardRDNs(rdns) > 1:
+			errs = append(errs, strictScopeErr(scope, dn, `contradictory`))
+		case scope == Subordinate && leafWildcard(rdns):
+			errs = append(errs, strictScopeErr(scope, dn, `contradictory`))
+		case scope == Subtree && leafWildcard(rdns):
+			errs = append(errs, strictScopeErr(scope, dn, `redundant`))
+		}
+	}
+
+	return
+}
+
+/*
+instructionScope returns the [SearchScope] of the first equality-based [TargetScope] rule found within the input [Instruction], else noScope.
+*/
+func instructionScope(i Instruction) (scope SearchScope) {
+	trs := i.TRs()
+	for j := 0; j < trs.Len(); j++ {
+		tr := trs.Index(j)
+		if tr.Keyword() != TargetScope || tr.Operator() != Eq {
+			continue
+		}
+
+		if s, ok := tr.Expression().(SearchScope); ok {
+			return s
+		}
+	}
+
+	return
+}
+
+/*
+wildcardRDNs returns the number of RDNs within rdns that bear one (1) or more wildcards (*).
+*/
+func wildcardRDNs(rdns []string) (n int) {
+	for i := 0; i < len(rdns); i++ {
+		if contains(rdns[i], `*`) {
+			n++
+		}
+	}
+
+	return
+}
+
+/*
+leafWildcard returns a Boolean value indicative of whether the leftmost RDN within rdns bears a lone wildcard (*) value, such as "uid=*".
+*/
+func leafWildcard(rdns []string) bool {
+	if len(rdns) == 0 {
+		return false
+	}
+
+	rdn := trimS(rdns[0])
+	if idx := idxr(rdn, '='); idx != -1 {
+		rdn = trimS(rdn[idx+1:])
+	}
+
+	return rdn == `*`
+}
+
+/*
+KeywordPolicy describes the [TargetKeyword], [BindKeyword] and [Right] values permitted within an [Instruction], for use with the [Instruction.ValidAgainst] method. This allows an organization to enforce its own ACI style guide programmatically.
+
+A nil slice field imposes no restriction upon its respective category, thus the zero value of this type is entirely permissive. See also the [DefaultKeywordPolicy] function.
+*/
+type KeywordPolicy struct {
+	TargetKeywords []TargetKeyword
+	BindKeywords   []BindKeyword
+	Rights         []Right
+}
+
+/*
+DefaultKeywordPolicy returns a permissive instance of [KeywordPolicy] which explicitly lists all [TargetKeyword], [BindKeyword] and [Right] values known to this package. The return value may be pared down as needed.
+*/
+func DefaultKeywordPolicy() (p KeywordPolicy) {
+	for kw := Target; kw <= TargetExtOp; kw++ {
+		p.TargetKeywords = append(p.TargetKeywords, kw)
+	}
+
+	for kw := BindUDN; kw <= BindSSF; kw++ {
+		p.BindKeywords = append(p.BindKeywords, kw)
+	}
+
+	p.Rights = rightsSlice(AllAccess | ProxyAccess)
+
+	return
+}
+
+/*
+ValidAgainst returns an error if the receiver fails the checks of [Instruction.Valid], or if any [TargetKeyword], [BindKeyword] or [Right] found within the receiver is not permitted by policy. All findings are joined into a single error through use of [errors.Join].
+
+[BindRule] instances are considered at any depth, including those enclosed within negated (NOT) [BindRules] stacks.
+*/
+func (r Instruction) ValidAgainst(policy KeywordPolicy) (err error) {
+	if err = r.Valid(); err != nil {
+		return
+	}
+
+	var errs []error
+	for _, cond := range r.Conditions() {
+		if !policy.allowsKeyword(cond.Keyword) {
+			errs = append(errs, policyKeywordErr(cond.Scope, cond.Keyword))
+		}
+	}
+
+	pbrs := r.PBRs()
+	for i := 0; i < pbrs.Len(); i++ {
+		for _, right := range rightsSlice(pbrs.Index(i).Permission().bits()) {
+			if !policy.allowsRight(right) {
+				errs = append(errs, policyRightErr(right))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+/*
+allowsKeyword returns a Boolean value indicative of whether kw is permitted by the receiver.
+*/
+func (r KeywordPolicy) allowsKeyword(kw Keyword) bool {
+	switch tv := kw.(type) {
+	case TargetKeyword:
+		if r.TargetKeywords == nil {
+			return true
+		}
+		for _, allowed := range r.TargetKeywords {
+			if tv == allowed {
+				return true
+			}
+		}
+	case BindKeyword:
+		if r.BindKeywords == nil {
+			return true
+		}
+		for _, allowed := range r.BindKeywords {
+			if tv == allowed {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+/*
+allowsRight returns a Boolean value indicative of whether right is permitted by the receiver.
+*/
+func (r KeywordPolicy) allowsRight(right Right) bool {
+	if r.Rights == nil {
+		return true
+	}
+
+	for _, allowed := range r.Rights {
+		if right == allowed {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,321 @@
+package aci
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleInstruction_ValidStrict() {
+	i := ACI(`People one-level`,
+		TRs().Push(
+			TDN(`uid=*,ou=*,dc=example,dc=com`).Eq(),
+			SingleLevel.Eq(),
+		),
+		PBR(Allow(ReadAccess), AllDN.Eq()),
+	)
+
+	fmt.Println(i.Valid())
+	fmt.Println(i.ValidStrict())
+	// Output:
+	// <nil>
+	// contradictory onelevel scope for target DN pattern 'ldap:///uid=*,ou=*,dc=example,dc=com'
+}
+
+func TestInstruction_ValidStrict(t *testing.T) {
+	for idx, tc := range []struct {
+		dn    string
+		scope SearchScope
+		ok    bool
+	}{
+		{`uid=*,ou=*,dc=example,dc=com`, SingleLevel, false},
+		{`ou=*,dc=example,dc=com`, SingleLevel, true},
+		{`uid=*,ou=People,dc=example,dc=com`, Subordinate, false},
+		{`uid=*,ou=People,dc=example,dc=com`, Subtree, false},
+		{`uid=*,ou=People,dc=example,dc=com`, BaseObject, true},
+		{`uid=j*,ou=People,dc=example,dc=com`, Subtree, true},
+		{`ou=People,dc=example,dc=com`, Subordinate, true},
+	} {
+		i := ACI(`strict`,
+			TRs().Push(TDN(tc.dn).Eq(), tc.scope.Eq()),
+			PBR(Allow(ReadAccess), AllDN.Eq()),
+		)
+
+		if err := i.Valid(); err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		} else if err = i.ValidStrict(); (err == nil) != tc.ok {
+			t.Errorf("%s[%d] failed: want ok=%t, got %v", t.Name(), idx, tc.ok, err)
+			return
+		}
+	}
+
+	var zero Instruction
+	if err := zero.ValidStrict(); err == nil {
+		t.Errorf("%s failed: expected error for zero %T, got nil", t.Name(), zero)
+	}
+}
+
+func TestInstruction_ValidStrict_rights(t *testing.T) {
+	var parsed Instruction
+	if err := parsed.Parse(`(targetattr="cn")(version 3.0; acl "Parsed"; allow(none,read) userdn="ldap:///all";)`); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	for idx, tc := range []struct {
+		i    Instruction
+		want string
+	}{
+		{parsed, `contradictory use of 'none' in allow(read)`},
+		{ACI(`a`, TRs().Push(TAs(`cn`).Eq()), PBR(Allow(AllAccess, ReadAccess), AllDN.Eq())), `redundant use of 'all' in allow(all)`},
+		{ACI(`b`, TRs().Push(TAs(`cn`).Eq()), PBR(Deny(WriteAccess, SelfWriteAccess), AllDN.Eq())), `dubious use of 'selfwrite' in deny(write,selfwrite)`},
+		{ACI(`c`, TRs().Push(TAs(`cn`).Eq()), PBR(Allow(SelfWriteAccess), AllDN.Eq())), ``},
+		{ACI(`d`, TRs().Push(TAs(`cn`).Eq()), PBR(Allow(AllAccess, ProxyAccess), AllDN.Eq())), ``},
+		{ACI(`e`, TRs().Push(TAs(`cn`).Eq()), PBR(Allow(AllAccess).Remove(`write`), AllDN.Eq())), ``},
+	} {
+		if err := tc.i.Valid(); err != nil {
+			t.Errorf("%s[%d] failed: lenient validation failed: %v", t.Name(), idx, err)
+			return
+		}
+
+		var got string
+		if err := tc.i.ValidStrict(); err != nil {
+			got = err.Error()
+		}
+
+		if got != tc.want {
+			t.Errorf("%s[%d] failed:\nwant: %s\ngot:  %s", t.Name(), idx, tc.want, got)
+			return
+		}
+	}
+
+	// the dubious deny set is user-controlled
+	defer func(orig Right) { DubiousDenyRights = orig }(DubiousDenyRights)
+	DubiousDenyRights = NoAccess
+	i := ACI(`f`, TRs().Push(TAs(`cn`).Eq()), PBR(Deny(SelfWriteAccess), AllDN.Eq()))
+	if err := i.ValidStrict(); err != nil {
+		t.Errorf("%s failed: unexpected error with check disabled: %v", t.Name(), err)
+	}
+}
+
+func ExampleInstruction_ValidAgainst() {
+	policy := DefaultKeywordPolicy()
+	policy.BindKeywords = []BindKeyword{BindUDN, BindGDN, BindSSF}
+
+	i := ACI(`Network readers`,
+		TRs().Push(TAs(`cn`).Eq()),
+		PBR(Allow(ReadAccess), And(GDN(`cn=Readers,ou=Groups,dc=example,dc=com`).Eq(), IP(`10.0.0.0/8`).Eq())),
+	)
+
+	fmt.Println(i.ValidAgainst(policy))
+	// Output: bind keyword 'ip' not permitted by policy
+}
+
+func TestInstruction_ValidAgainst(t *testing.T) {
+	i := ACI(`Policy`,
+		TRs().Push(TAs(`cn`).Eq(), Filter(`(objectClass=person)`).Eq()),
+		PBR(Allow(ReadAccess, WriteAccess), Or(UDN(`uid=jesse,ou=People,dc=example,dc=com`).Eq(), Not(SSF(128).Lt()))),
+		PBR(Deny(ProxyAccess), AnyDN.Eq()),
+	)
+
+	for idx, tc := range []struct {
+		policy KeywordPolicy
+		want   []string
+	}{
+		{KeywordPolicy{}, nil},
+		{DefaultKeywordPolicy(), nil},
+		{KeywordPolicy{TargetKeywords: []TargetKeyword{TargetAttr}}, []string{`target keyword 'targetfilter'`}},
+		{KeywordPolicy{BindKeywords: []BindKeyword{BindUDN}}, []string{`bind keyword 'ssf'`}},
+		{KeywordPolicy{Rights: []Right{ReadAccess}}, []string{`right 'write'`, `right 'proxy'`}},
+		{KeywordPolicy{TargetKeywords: []TargetKeyword{}, BindKeywords: []BindKeyword{}, Rights: []Right{}}, []string{
+			`target keyword 'targetattr'`, `target keyword 'targetfilter'`,
+			`bind keyword 'userdn'`, `bind keyword 'ssf'`, `bind keyword 'userdn'`,
+			`right 'read'`, `right 'write'`, `right 'proxy'`,
+		}},
+	} {
+		err := i.ValidAgainst(tc.policy)
+		if len(tc.want) == 0 {
+			if err != nil {
+				t.Errorf("%s[%d] failed: unexpected error: %v", t.Name(), idx, err)
+				return
+			}
+			continue
+		} else if err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nil", t.Name(), idx)
+			return
+		}
+
+		lines := split(err.Error(), "\n")
+		if len(lines) != len(tc.want) {
+			t.Errorf("%s[%d] failed: want %d findings, got %d: %v", t.Name(), idx, len(tc.want), len(lines), err)
+			return
+		}
+		for j, want := range tc.want {
+			if !hasPfx(lines[j], want) {
+				t.Errorf("%s[%d] failed:\nwant: %s\ngot:  %s", t.Name(), idx, want, lines[j])
+				return
+			}
+		}
+	}
+
+	var zero Instruction
+	if err := zero.ValidAgainst(DefaultKeywordPolicy()); err == nil {
+		t.Errorf("%s failed: expected error for zero %T, got nil", t.Name(), zero)
+	}
+}
+
+func TestInstruction_ValidStrict_depth(t *testing.T) {
+	i := ACI(`Deep`,
+		TRs().Push(TAs(`cn`).Eq()),
+		PBR(Allow(ReadAccess), And(SSF(128).Ge(), Or(SASL.Eq(), Not(Simple.Eq())))),
+		PBR(Allow(SearchAccess), AllDN.Eq()),
+	)
+
+	defer func(orig int) { MaxBindRuleDepth = orig }(MaxBindRuleDepth)
+	for idx, tc := range []struct {
+		max  int
+		want string
+	}{
+		{0, ``},
+		{3, ``},
+		{2, `excessive bind rule depth 3 for allow(read); maximum is 2`},
+	} {
+		MaxBindRuleDepth = tc.max
+
+		var got string
+		if err := i.ValidStrict(); err != nil {
+			got = err.Error()
+		}
+
+		if got != tc.want {
+			t.Errorf("%s[%d] failed:\nwant: %s\ngot:  %s", t.Name(), idx, tc.want, got)
+			return
+		}
+	}
+}
+
+func TestInstruction_ValidStrict_attrWildcard(t *testing.T) {
+	for idx, tc := range []struct {
+		ats  AttributeTypes
+		want string
+	}{
+		{TAs(`*`), ``},
+		{TAs(`cn`, `sn`), ``},
+		{TAs(`*`, `aci`), `product-specific targetattr wildcard combination '* || aci'`},
+	} {
+		i := ACI(`Wildcard`,
+			TRs().Push(tc.ats.Eq()),
+			PBR(Allow(ReadAccess), AllDN.Eq()),
+		)
+
+		var got string
+		if err := i.ValidStrict(); err != nil {
+			got = err.Error()
+		}
+
+		if got != tc.want {
+			t.Errorf("%s[%d] failed:\nwant: %s\ngot:  %s", t.Name(), idx, tc.want, got)
+			return
+		}
+	}
+}
+
+func TestInstruction_ValidStrict_timeOfDay(t *testing.T) {
+	defer func(orig bool) { StrictTimeOfDayEquality = orig }(StrictTimeOfDayEquality)
+	for idx, tc := range []struct {
+		ctx      BindContext
+		suppress bool
+		want     string
+	}{
+		{ToD(`1730`).Eq(), false, `dubious timeofday equality 'timeofday = "1730"'; consider a range (e.g.: >= and <=)`},
+		{And(AllDN.Eq(), ToD(`0800`).Ne()), false, `dubious timeofday equality 'timeofday != "0800"'; consider a range (e.g.: >= and <=)`},
+		{Timeframe(ToD(`0800`), ToD(`1700`)), false, ``},
+		{ToD(`1730`).Eq(), true, ``},
+	} {
+		i := ACI(`Hours`,
+			TRs().Push(TAs(`cn`).Eq()),
+			PBR(Allow(ReadAccess), tc.ctx),
+		)
+
+		StrictTimeOfDayEquality = !tc.suppress
+
+		var got string
+		if err := i.ValidStrict(); err != nil {
+			got = err.Error()
+		}
+
+		if got != tc.want {
+			t.Errorf("%s[%d] failed:\nwant: %s\ngot:  %s", t.Name(), idx, tc.want, got)
+			return
+		}
+	}
+}
+
+func TestInstruction_ValidStrict_ssf(t *testing.T) {
+	for idx, tc := range []struct {
+		br   BindRule
+		want string
+	}{
+		{SSF(256).Gt(), `impossible ssf threshold 'ssf > "256"'; valid range is 0-256`},
+		{SSF(0).Lt(), `impossible ssf threshold 'ssf < "0"'; valid range is 0-256`},
+		{SSF(256).Ge(), ``},
+		{SSF(128).Gt(), ``},
+	} {
+		i := ACI(`Encrypted`,
+			TRs().Push(TAs(`cn`).Eq()),
+			PBR(Allow(ReadAccess), And(AllDN.Eq(), tc.br)),
+		)
+
+		var got string
+		if err := i.ValidStrict(); err != nil {
+			got = err.Error()
+		}
+
+		if got != tc.want {
+			t.Errorf("%s[%d] failed:\nwant: %s\ngot:  %s", t.Name(), idx, tc.want, got)
+			return
+		}
+	}
+}
+
+func TestInstruction_ValidStrict_siblings(t *testing.T) {
+	defer func(orig bool) { StrictTimeOfDayEquality = orig }(StrictTimeOfDayEquality)
+	StrictTimeOfDayEquality = false
+
+	for idx, tc := range []struct {
+		ctx  BindContext
+		want string
+	}{
+		{And(AllDN.Eq(), SSF(128).Ge(), SSF(64).Lt()), `contradictory ssf conditions within AND stack 'userdn = "ldap:///all" AND ssf >= "128" AND ssf < "64"'`},
+		{And(ToD(`0800`).Eq(), ToD(`0900`).Eq()), `contradictory timeofday conditions within AND stack 'timeofday = "0800" AND timeofday = "0900"'`},
+		{And(SSF(128).Ge(), And(AllDN.Eq(), SSF(128).Le(), SSF(128).Ne())), `contradictory ssf conditions within AND stack 'ssf >= "128" AND userdn = "ldap:///all" AND ssf <= "128" AND ssf != "128"'`},
+		{And(AllDN.Eq(), AllDN.Ne()), `contradictory userdn conditions within AND stack 'userdn = "ldap:///all" AND userdn != "ldap:///all"'`},
+		{And(AllDN.Eq(), SASL.Eq(), Simple.Eq()), `contradictory authmethod conditions within AND stack 'userdn = "ldap:///all" AND authmethod = "SASL" AND authmethod = "SIMPLE"'`},
+		{Or(Anyone().Eq(), SSF(56).Gt(), Anyone().Eq()), `redundant userdn conditions within OR stack 'userdn = "ldap:///anyone" OR ssf > "56" OR userdn = "ldap:///anyone"'`},
+		{Or(SSF(128).Ge(), SSF(64).Lt()), ``},
+		{And(SSF(64).Ge(), SSF(128).Lt()), ``},
+		{Timeframe(ToD(`0800`), ToD(`1700`)), ``},
+		{And(AllDN.Eq(), Or(SSF(128).Ge(), SSF(64).Lt())), ``},
+		{And(AllDN.Eq(), Not().Push(SSF(128).Ge())), ``},
+	} {
+		i := ACI(`Siblings`,
+			TRs().Push(TAs(`cn`).Eq()),
+			PBR(Allow(ReadAccess), tc.ctx),
+		)
+
+		if err := i.Valid(); err != nil {
+			t.Errorf("%s[%d] failed: default Valid is not lenient: %v", t.Name(), idx, err)
+			return
+		}
+
+		var got string
+		if err := i.ValidStrict(); err != nil {
+			got = err.Error()
+		}
+
+		if got != tc.want {
+			t.Errorf("%s[%d] failed:\nwant: %s\ngot:  %s", t.Name(), idx, tc.want, got)
+			return
+		}
+	}
+}
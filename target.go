@@ -4,6 +4,10 @@ package aci
 target.go contains target rule(s) types, functions and methods.
 */
 
+import (
+	"errors"
+)
+
 var (
 	badTargetRule  TargetRule
 	badTargetRules TargetRules
@@ -58,6 +62,20 @@ func (r TargetRuleMethods) Index(idx any) (ComparisonOperator, TargetRuleMethod)
 	return r.index(idx)
 }
 
+/*
+rule is a private method called by the Rule method extended through all eligible types. The [TargetRuleMethod] indexed by op is executed, and its return value is verified to bear a [ComparisonOperator] permitted for use with its [Keyword].
+*/
+func (r TargetRuleMethods) rule(op any) (b TargetRule) {
+	b = badTargetRule
+	if cop, meth := r.index(op); meth != nil {
+		if br := meth(); !br.IsZero() && keywordAllowsComparisonOperator(br.Keyword(), cop) {
+			b = br
+		}
+	}
+
+	return
+}
+
 /*
 index is a private method called by TargetRuleMethods.Index.
 */
@@ -161,6 +179,23 @@ func (r TargetRuleMethods) Len() int {
 	return len((*r.targetRuleFuncMap))
 }
 
+/*
+Names returns the [ComparisonOperator] contexts (e.g.: `Eq`, `Ne`) available within the receiver, in ascending operator order. This allows the [TargetRule] types supported by a given value to be introspected without iterating [TargetRuleMethods.Index]. A nil slice is returned if the receiver is nil, or unset.
+*/
+func (r TargetRuleMethods) Names() (names []string) {
+	if r.IsZero() {
+		return
+	}
+
+	for cop := Eq; cop <= Ge; cop++ {
+		if _, found := (*r.targetRuleFuncMap)[cop]; found {
+			names = append(names, cop.Context())
+		}
+	}
+
+	return
+}
+
 /*
 TargetRuleMethod is the closure signature for methods used to build new instances of [TargetRule].
 
@@ -230,6 +265,10 @@ func newTargetRule(kw, op, ex any) (t TargetRule) {
 		SetID(targetRuleID).
 		NoPadding(!RulePadding)
 
+	if MultivalQuoteStyle != MultivalOuterQuotes {
+		t.SetQuoteStyle(MultivalQuoteStyle)
+	}
+
 	return
 }
 
@@ -249,7 +288,13 @@ func (r TargetRule) Valid() (err error) {
 			_t.Keyword())
 		return
 	}
-	err = _t.Valid()
+	if err = _t.Valid(); err == nil {
+		// consult any SchemaChecker in effect
+		if ats, ok := r.Expression().(AttributeTypes); ok {
+			err = ats.Valid()
+		}
+	}
+
 	return
 }
 
@@ -314,6 +359,37 @@ func (r TargetRule) String() string {
 	return tr.String()
 }
 
+/*
+StringBare returns the string representation of the receiver instance without the enclosing parenthetical characters otherwise imposed by [TargetRule.String], e.g.:
+
+	targetattr = "cn || sn"
+
+This is useful when composing custom output, such as when embedding the receiver within a larger expression. The parenthetical state of the receiver is not altered.
+*/
+func (r TargetRule) StringBare() string {
+	if r.IsZero() {
+		return ``
+	}
+
+	tr := r.cast()
+	return unparen(tr.String(), tr.IsParen())
+}
+
+/*
+Describe returns a human-readable representation of the receiver instance in which the [ComparisonOperator] is rendered using its description (see [ComparisonOperator.Description]), e.g.:
+
+	targetattr [Not Equal To] "userPassword"
+
+This format is intended for logging and debugging purposes only; it is not valid ACIv3 syntax. See [TargetRule.String] for the wire form.
+*/
+func (r TargetRule) Describe() string {
+	if r.IsZero() {
+		return ``
+	}
+
+	return describeRule(r.Keyword(), r.Operator(), r.StringBare())
+}
+
 /*
 NoPadding wraps the [stackage.Condition.NoPadding] method.
 */
@@ -520,8 +596,12 @@ Please note that instances of this design are set with a maximum capacity of nin
 Instances of this design generally are assigned to top-level instances of [Instruction], and never allow nesting elements (e.g.: other [stackage.Stack] derived type aliases).
 
 Padding is disabled by default, meaning there shall be no whitespace residing between individual [TargetRule] instances. This behavior can be altered using the NoPadding method.
+
+A [PaddingOption], created using the [WithPadding] function, may be submitted to override the [RulePadding] global variable for the return instance and its [TargetRule] instances.
 */
 func TRs(x ...any) (t TargetRules) {
+	x, opt := paddingOption(x)
+
 	// create a native stackage.Stack
 	// and configure before typecast.
 	_t := stackList(9).
@@ -549,6 +629,10 @@ func TRs(x ...any) (t TargetRules) {
 	// the return instance.
 	t.Push(x...)
 
+	if opt != nil {
+		applyPadding(t, opt.pad)
+	}
+
 	return
 }
 
@@ -600,6 +684,60 @@ func (r TargetRules) Push(x ...any) TargetRules {
 	return r
 }
 
+/*
+Add converts each of the input builders into a [TargetRule] by way of its Eq method, and pushes the result into the receiver. Valid input types are those which produce an Equal-To [TargetRule], namely:
+
+  - [TargetDistinguishedName] and [TargetDistinguishedNames]
+  - [SearchFilter]
+  - [ObjectIdentifier] and [ObjectIdentifiers]
+  - [AttributeType] and [AttributeTypes]
+  - [AttributeFilterOperation] and [AttributeFilterOperations]
+  - [SearchScope]
+
+A [TargetRule] instance may also be submitted, and is pushed as-is. Input values which are of an unsupported type, which produce an invalid [TargetRule], or which bear a [TargetKeyword] already present within the receiver are silently discarded. See [TargetRules.TryAdd] for a variant which reports such failures.
+*/
+func (r TargetRules) Add(builders ...any) TargetRules {
+	_ = r.TryAdd(builders...)
+	return r
+}
+
+/*
+TryAdd performs the same tasks as [TargetRules.Add], except that an error is returned describing each input value that was discarded, identified by its index. All other values are pushed regardless.
+*/
+func (r TargetRules) TryAdd(builders ...any) error {
+	var errs []error
+	for i := 0; i < len(builders); i++ {
+		if err := r.add(builders[i]); err != nil {
+			errs = append(errs, targetAddErr(i, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+/*
+add is a private method called by TargetRules.TryAdd.
+*/
+func (r TargetRules) add(builder any) (err error) {
+	var tr TargetRule
+	switch tv := builder.(type) {
+	case TargetRule:
+		tr = tv
+	case interface{ Eq() TargetRule }:
+		tr = tv.Eq()
+	default:
+		return pushErrorBadType(r, builder, nil)
+	}
+
+	if err = tr.Valid(); err == nil {
+		if err = r.pushPolicy(tr); err == nil {
+			r.Push(tr)
+		}
+	}
+
+	return
+}
+
 /*
 Pop wraps the [stackage.Stack.Pop] method. An instance of [TargetRule] is returned following a call of this method.
 
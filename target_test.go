@@ -2,6 +2,8 @@ package aci
 
 import (
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -356,6 +358,36 @@ func ExampleTargetRuleMethods_Len() {
 	// Output: There is one (1) available aci.TargetRuleMethod instance for creating aci.SearchScope TargetRules
 }
 
+func ExampleTargetRuleMethods_Names() {
+	var sco SearchScope = SingleLevel
+	fmt.Printf("%v", sco.TRM().Names())
+	// Output: [Eq]
+}
+
+func TestTargetRuleMethods_Names(t *testing.T) {
+	for idx, tc := range []struct {
+		trm  TargetRuleMethods
+		want string
+	}{
+		{TAs(`cn`, `sn`).TRM(), `[Eq Ne]`},
+		{Subtree.TRM(), `[Eq]`},
+		{TargetRuleMethods{}, `[]`},
+	} {
+		if got := fmt.Sprintf("%v", tc.trm.Names()); got != tc.want {
+			t.Errorf("%s[%d] failed: want %s, got %s", t.Name(), idx, tc.want, got)
+			return
+		}
+
+		// every name must resolve through Index
+		for _, name := range tc.trm.Names() {
+			if cop, meth := tc.trm.Index(name); cop.Context() != name || meth == nil {
+				t.Errorf("%s[%d] failed: %s did not resolve", t.Name(), idx, name)
+				return
+			}
+		}
+	}
+}
+
 func ExampleTargetRuleMethod() {
 	tfil := Filter(`(&(objectClass=employee)(terminated=FALSE))`)
 	trm := tfil.TRM()
@@ -455,6 +487,50 @@ func ExampleTargetRules_Push() {
 	// Output: targetscope
 }
 
+func ExampleTargetRules_Add() {
+	trs := TRs().Add(
+		TDN(`ou=People,dc=example,dc=com`),
+		Filter(`(objectClass=person)`),
+		TAs(`cn`, `sn`),
+		Subtree,
+	)
+
+	fmt.Printf("%s", trs)
+	// Output: ( target = "ldap:///ou=People,dc=example,dc=com" )( targetfilter = "(objectClass=person)" )( targetattr = "cn || sn" )( targetscope = "subtree" )
+}
+
+func TestTargetRules_TryAdd(t *testing.T) {
+	trs := TRs()
+	err := trs.TryAdd(
+		TDN(`ou=People,dc=example,dc=com`),
+		ExtOps(`1.3.6.1.4.1.56521.999.5`),
+		Anyone(),                           // BindDistinguishedName; wrong Eq
+		`targetattr`,                       // unsupported type
+		TDN(`ou=Groups,dc=example,dc=com`), // duplicate keyword
+		TDN(``),                            // invalid
+		Filter(`(cn=*)`).Ne(),              // rules are accepted as-is
+	)
+
+	if err == nil {
+		t.Errorf("%s failed: expected error, got nil", t.Name())
+		return
+	} else if trs.Len() != 3 {
+		t.Errorf("%s failed: want 3 rules, got %d: %s", t.Name(), trs.Len(), trs)
+		return
+	}
+
+	for _, idx := range []string{`#2`, `#3`, `#4`, `#5`} {
+		if !strings.Contains(err.Error(), `builder `+idx+` `) {
+			t.Errorf("%s failed: no error reported for builder %s: %v", t.Name(), idx, err)
+			return
+		}
+	}
+
+	if err = TRs().TryAdd(SingleLevel, TAs(`*`)); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	}
+}
+
 func ExampleTargetRules_Kind() {
 	var trs TargetRules
 	fmt.Printf("%s", trs.Kind())
@@ -729,3 +805,82 @@ func ExampleTargetRule_Len() {
 	fmt.Printf("%T.Len: %d", tr, tr.Len())
 	// Output: aci.TargetRule.Len: 1
 }
+
+func TestTargetRule_StringBare_concurrent(t *testing.T) {
+	tr := TAs(`cn`, `sn`).Eq()
+	want := tr.String()
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 16)
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_ = tr.StringBare()
+			_ = tr.Describe()
+		}()
+		go func() {
+			defer wg.Done()
+			if got := tr.String(); got != want {
+				errs <- got
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for got := range errs {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+}
+
+func ExampleTargetRule_StringBare() {
+	tr := TAs(`cn`, `sn`).Eq()
+
+	fmt.Println(tr.StringBare())
+	fmt.Println(tr)
+	// Output:
+	// targetattr = "cn || sn"
+	// ( targetattr = "cn || sn" )
+}
+
+func ExampleTargetDistinguishedName_Rule() {
+	fmt.Println(TDN(`ou=People,dc=example,dc=com`).Rule(`Ne`))
+	// Output: ( target != "ldap:///ou=People,dc=example,dc=com" )
+}
+
+func TestTargetRule_byOperator(t *testing.T) {
+	for idx, tc := range []struct {
+		rule TargetRule
+		want string
+	}{
+		{TAs(`cn`, `sn`).Rule(Eq), `( targetattr = "cn || sn" )`},
+		{Filter(`(objectClass=*)`).Rule(`!=`), `( targetfilter != "(objectClass=*)" )`},
+		{Subtree.Rule(`Equal To`), `( targetscope = "subtree" )`},
+		{Subtree.Rule(Ne), ``},
+		{TDN(`ou=People,dc=example,dc=com`).Rule(Lt), ``},
+	} {
+		if got := tc.rule.String(); got != tc.want {
+			t.Errorf("%s[%d] failed: want '%s', got '%s'", t.Name(), idx, tc.want, got)
+			return
+		}
+	}
+}
+
+func ExampleRule() {
+	for _, r := range []Rule{
+		TAs(`cn`, `sn`).Eq(),
+		SSF(128).Ge(),
+	} {
+		fmt.Printf("%s %s\n", r.Keyword(), r.Operator().Context())
+	}
+	// Output:
+	// targetattr Eq
+	// ssf Ge
+}
+
+func ExampleTargetRule_Describe() {
+	fmt.Println(TAs(`userPassword`).Ne().Describe())
+	// Output: targetattr [Not Equal To] "userPassword"
+}
@@ -49,6 +49,31 @@ func parseDoW(dow string) (d DayOfWeek, err error) {
 	return
 }
 
+/*
+ParseDayOfWeek returns an instance of [DayOfWeek] alongside an error following an attempt to parse raw, which must be a comma-delimited list of day names. Case is not significant, and each name may be abbreviated (e.g.: `Mon`, `Tue`, `Tues`) or given in full (e.g.: `Monday`). This is useful when ingesting dayofweek values produced by various tools.
+
+An error is returned if any name is unrecognized, or if any day appears more than once (e.g.: `Mon,monday`). The [DayOfWeek.String] method of the return instance produces the canonical form, e.g.: `Sun,Mon,Tues,Wed,Thur,Fri,Sat`.
+*/
+func ParseDayOfWeek(raw string) (d DayOfWeek, err error) {
+	d = newDoW()
+	X := split(repAll(raw, ` `, ``), `,`)
+	for i := 0; i < len(X); i++ {
+		dw := matchStrDoW(X[i])
+		if dw == noDay {
+			err = dowBadDayErr(X[i])
+		} else if d.Positive(dw) {
+			err = dowDuplicateDayErr(X[i])
+		}
+
+		if err != nil {
+			return DayOfWeek{}, err
+		}
+		d.Shift(dw)
+	}
+
+	return
+}
+
 func matchDoW(d any) (D Day) {
 	D = noDay
 	switch tv := d.(type) {
@@ -70,11 +95,11 @@ func matchStrDoW(d string) (D Day) {
 		D = Sun
 	case `mon`, `monday`, `2`:
 		D = Mon
-	case `tues`, `tuesday`, `3`:
+	case `tue`, `tues`, `tuesday`, `3`:
 		D = Tues
 	case `wed`, `wednesday`, `4`:
 		D = Wed
-	case `thur`, `thurs`, `thursday`, `5`:
+	case `thu`, `thur`, `thurs`, `thursday`, `5`:
 		D = Thur
 	case `fri`, `friday`, `6`:
 		D = Fri
@@ -164,6 +189,84 @@ func Weekend(cop any) (b BindRule) {
 	return
 }
 
+/*
+weekdays maps each [time.Weekday] value to its corresponding [Day].
+*/
+var weekdays = [7]Day{Sun, Mon, Tues, Wed, Thur, Fri, Sat}
+
+/*
+DaysBetween returns an instance of [DayOfWeek] bearing every [Day] from start through end, inclusive. For example, [time.Monday] through [time.Friday] produces "Mon,Tues,Wed,Thur,Fri".
+
+Ranges wrap around the end of the week when end falls chronologically before start. For example, [time.Friday] through [time.Monday] produces "Sun,Mon,Fri,Sat". Identical start and end values produce a single [Day].
+
+A zero [DayOfWeek] instance is returned if either input value is not a valid [time.Weekday].
+*/
+func DaysBetween(start, end time.Weekday) (d DayOfWeek) {
+	if !validWeekday(start) || !validWeekday(end) {
+		return
+	}
+
+	d = newDoW()
+	for w := start; ; w = (w + 1) % 7 {
+		d.Shift(weekdays[w])
+		if w == end {
+			break
+		}
+	}
+
+	return
+}
+
+func validWeekday(w time.Weekday) bool {
+	return time.Sunday <= w && w <= time.Saturday
+}
+
+/*
+Union returns a new instance of [DayOfWeek] bearing every [Day] that is positive within the receiver or within any of the input [DayOfWeek] instances. Neither the receiver nor the input instances are modified.
+*/
+func (r DayOfWeek) Union(x ...DayOfWeek) (d DayOfWeek) {
+	d = newDoW()
+	for _, day := range weekdays {
+		if r.Positive(day) {
+			d.Shift(day)
+			continue
+		}
+		for i := 0; i < len(x); i++ {
+			if x[i].Positive(day) {
+				d.Shift(day)
+				break
+			}
+		}
+	}
+
+	return
+}
+
+/*
+Intersect returns a new instance of [DayOfWeek] bearing only those [Day] values that are positive within the receiver AND within all of the input [DayOfWeek] instances. Neither the receiver nor the input instances are modified.
+
+If no [Day] values are common to all instances, the return instance will not be valid.
+*/
+func (r DayOfWeek) Intersect(x ...DayOfWeek) (d DayOfWeek) {
+	d = newDoW()
+	for _, day := range weekdays {
+		if r.Positive(day) && allPositive(day, x) {
+			d.Shift(day)
+		}
+	}
+
+	return
+}
+
+func allPositive(day Day, x []DayOfWeek) bool {
+	for i := 0; i < len(x); i++ {
+		if !x[i].Positive(day) {
+			return false
+		}
+	}
+	return true
+}
+
 /*
 Shift wraps [shifty.BitValue.Shift] method to allow for bit-shifting of the receiver (r) instance using various representations of any number of days (string, int or [Day]).
 */
@@ -317,6 +420,15 @@ func (r DayOfWeek) BRM() BindRuleMethods {
 	})
 }
 
+/*
+Rule returns a [BindRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [BindRule] is returned if op is not permitted for use with the receiver.
+
+See also the [DayOfWeek.BRM] method.
+*/
+func (r DayOfWeek) Rule(op any) BindRule {
+	return r.BRM().rule(op)
+}
+
 /*
 String is a stringer method that returns a single string name value for receiver instance of [Day].
 */
@@ -395,6 +507,43 @@ func Timeframe(notBefore, notAfter TimeOfDay) (window BindRules) {
 	return
 }
 
+/*
+ToDBetween is a convenience function that returns a parenthetical [BindRules] instance expressing the timeframe between start (inclusive) and end (exclusive), such as:
+
+	( timeofday >= "1730" AND timeofday < "2400" )
+
+Both input values must be four (4) digit 24-hour clock values; the ACI-specific "2400" sentinel is permitted. The start value must be chronologically earlier than the end value.
+
+A zero [BindRules] instance is returned if either bound is invalid, or if the bounds are out of order.
+*/
+func ToDBetween(start, end string) BindRules {
+	s, sok := todBound(start)
+	e, eok := todBound(end)
+	if !sok || !eok || s >= e {
+		return BindRules{}
+	}
+
+	return Timeframe(ToD(start), ToD(end)).Paren()
+}
+
+/*
+todBound returns the integer value of clock string x alongside a Boolean value indicative of its validity as a [TimeOfDay] bound.
+*/
+func todBound(x string) (n int, ok bool) {
+	if len(x) != 4 {
+		return
+	} else if x == `2400` {
+		return 2400, true
+	}
+
+	if _, err := time.Parse(`1504`, x); err == nil {
+		n, err = atoi(x)
+		ok = err == nil
+	}
+
+	return
+}
+
 /*
 Keyword wraps the [stackage.Condition.Keyword] method and resolves the raw value into a [BindKeyword]. Failure to do so will return a bogus [Keyword].
 */
@@ -484,6 +633,15 @@ func (r TimeOfDay) BRM() BindRuleMethods {
 	})
 }
 
+/*
+Rule returns a [BindRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [BindRule] is returned if op is not permitted for use with the receiver.
+
+See also the [TimeOfDay.BRM] method.
+*/
+func (r TimeOfDay) Rule(op any) BindRule {
+	return r.BRM().rule(op)
+}
+
 /*
 Compare returns a Boolean value indicative of a SHA-1 comparison between the receiver (r) and input value x.
 */
@@ -526,6 +684,16 @@ func (r TimeOfDay) IsZero() bool {
 	return r.timeOfDay.isZero()
 }
 
+/*
+Len returns one (1) if the receiver is set, else zero (0). This method exists primarily for consistency with the other expression value types defined in this package.
+*/
+func (r TimeOfDay) Len() int {
+	if r.IsZero() {
+		return 0
+	}
+	return 1
+}
+
 /*
 Set encodes the specified 24-hour (a.k.a.: military) time value into the receiver instance.
 
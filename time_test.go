@@ -442,6 +442,91 @@ func ExampleTimeframe() {
 	// Output: ( timeofday >= "1730" AND timeofday < "2400" )
 }
 
+func ExampleToDBetween() {
+	fmt.Printf("%s", ToDBetween(`1730`, `2400`))
+	// Output: ( timeofday >= "1730" AND timeofday < "2400" )
+}
+
+func TestToDBetween(t *testing.T) {
+	for idx, bounds := range [][2]string{
+		{`0800`, `1700`},
+		{`0000`, `2400`},
+		{`2359`, `2400`},
+	} {
+		if tf := ToDBetween(bounds[0], bounds[1]); tf.IsZero() || tf.Valid() != nil {
+			t.Errorf("%s[%d] failed: unexpected invalid timeframe for %v", t.Name(), idx, bounds)
+			return
+		}
+	}
+
+	for idx, bounds := range [][2]string{
+		{`1700`, `0800`},
+		{`0800`, `0800`},
+		{`2400`, `2400`},
+		{`0477`, `1700`},
+		{`800`, `1700`},
+		{`0800`, `2401`},
+		{``, `1700`},
+	} {
+		if tf := ToDBetween(bounds[0], bounds[1]); !tf.IsZero() {
+			t.Errorf("%s[%d] failed: expected zero timeframe for %v, got %s", t.Name(), idx, bounds, tf)
+			return
+		}
+	}
+}
+
+func ExampleDaysBetween() {
+	fmt.Println(DaysBetween(time.Monday, time.Friday))
+	fmt.Println(DaysBetween(time.Friday, time.Monday))
+	// Output:
+	// Mon,Tues,Wed,Thur,Fri
+	// Sun,Mon,Fri,Sat
+}
+
+func ExampleDayOfWeek_Union() {
+	fmt.Println(DoW(Mon, Wed).Union(DoW(Fri), DoW(Sun)))
+	// Output: Sun,Mon,Wed,Fri
+}
+
+func ExampleDayOfWeek_Intersect() {
+	workweek := DaysBetween(time.Monday, time.Friday)
+	fmt.Println(workweek.Intersect(DoW(Sun, Mon, Sat), DoW(Mon, Tues)))
+	// Output: Mon
+}
+
+func TestDaysBetween(t *testing.T) {
+	if d := DaysBetween(time.Wednesday, time.Wednesday); d.String() != `Wed` {
+		t.Errorf("%s failed: want Wed, got %s", t.Name(), d)
+		return
+	}
+
+	if d := DaysBetween(time.Sunday, time.Saturday); d.Len() != 7 {
+		t.Errorf("%s failed: want 7 days, got %d", t.Name(), d.Len())
+		return
+	}
+
+	if d := DaysBetween(time.Saturday, time.Sunday); d.String() != `Sun,Sat` {
+		t.Errorf("%s failed: want Sun,Sat, got %s", t.Name(), d)
+		return
+	}
+
+	if d := DaysBetween(time.Weekday(7), time.Monday); !d.IsZero() {
+		t.Errorf("%s failed: expected zero %T, got %s", t.Name(), d, d)
+		return
+	}
+
+	a := DoW(Mon)
+	if u := a.Union(DoW(Tues)); a.String() != `Mon` || u.String() != `Mon,Tues` {
+		t.Errorf("%s failed: bad union (%s) or modified receiver (%s)", t.Name(), u, a)
+		return
+	}
+
+	if i := DoW(Mon).Intersect(DoW(Tues)); i.Valid() == nil {
+		t.Errorf("%s failed: expected invalid empty intersection, got %s", t.Name(), i)
+		return
+	}
+}
+
 func TestParseDoW(t *testing.T) {
 	failOK := func(x int) bool {
 		for _, val := range []int{
@@ -562,3 +647,54 @@ func TestDay_codecov(t *testing.T) {
 	_ = dow.Valid()
 	_ = dow.BRM()
 }
+
+func ExampleParseDayOfWeek() {
+	dow, err := ParseDayOfWeek(`monday, TUE,wednesday,Thu,FRI`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("%s", dow)
+	// Output: Mon,Tues,Wed,Thur,Fri
+}
+
+func TestParseDayOfWeek(t *testing.T) {
+	for idx, tc := range [][2]string{
+		{`Sun`, `Sun`},
+		{`saturday,sunday`, `Sun,Sat`},
+		{`Tue,THU`, `Tues,Thur`},
+		{`sUN,mon,tues,Wed,THURSDAY,friDAY,SATurDAy`, `Sun,Mon,Tues,Wed,Thur,Fri,Sat`},
+	} {
+		dow, err := ParseDayOfWeek(tc[0])
+		if err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		} else if got := dow.String(); got != tc[1] {
+			t.Errorf("%s[%d] failed: want %s, got %s", t.Name(), idx, tc[1], got)
+			return
+		}
+
+		// canonical output must round-trip
+		if again, err := ParseDayOfWeek(dow.String()); err != nil || again.String() != tc[1] {
+			t.Errorf("%s[%d] failed: round-trip of %s: %v", t.Name(), idx, dow, err)
+			return
+		}
+	}
+
+	for idx, bogus := range []string{
+		``,
+		`humpday`,
+		`Mon,,Tue`,
+		`Mon,monday`,
+		`Fri,Sat,FRIDAY`,
+	} {
+		if dow, err := ParseDayOfWeek(bogus); err == nil {
+			t.Errorf("%s[%d] failed: expected error for '%s', got %s", t.Name(), idx, bogus, dow)
+			return
+		} else if !dow.IsZero() {
+			t.Errorf("%s[%d] failed: expected zero %T", t.Name(), idx, dow)
+			return
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package aci
+
+/*
+token.go contains the lexical tokenizer for ACI strings.
+*/
+
+/*
+TokenKind describes the lexical category of a [Token]. See the [TokenKind] constants defined in this package for the available categories.
+*/
+type TokenKind uint8
+
+/*
+TokenKind constants describe each of the lexical categories recognized by [Tokenize].
+*/
+const (
+	_              TokenKind = iota // 0x0 <invalid>
+	KeywordToken                    // a TargetKeyword, BindKeyword or one of `version`, `acl`, `allow` and `deny`
+	OperatorToken                   // a ComparisonOperator, e.g.: `=` or `!=`
+	ValueToken                      // a double-quoted value, less the enclosing quotation marks
+	ParenToken                      // an opening or closing parenthesis
+	BooleanToken                    // a Boolean WORD operator, e.g.: `AND`, `OR` or `AND NOT`
+	DelimiterToken                  // a semicolon, comma or symbolic OR (`||`)
+	WordToken                       // any other unquoted sequence, e.g.: `3.0`, `read` or `128`
+)
+
+/*
+Token describes a single lexical element of an ACI string, as produced by [Tokenize].
+
+The Kind field describes the lexical category of the token. The Value field contains the literal text of the token; for a [ValueToken], this excludes the enclosing quotation marks, while any escape sequences are preserved as-is. The Pos field contains the byte offset of the token within the original input.
+*/
+type Token struct {
+	Kind  TokenKind
+	Value string
+	Pos   int
+}
+
+/*
+String returns the string name of the receiver, e.g.: `keyword`.
+*/
+func (r TokenKind) String() (s string) {
+	switch r {
+	case KeywordToken:
+		s = `keyword`
+	case OperatorToken:
+		s = `operator`
+	case ValueToken:
+		s = `value`
+	case ParenToken:
+		s = `paren`
+	case BooleanToken:
+		s = `boolean`
+	case DelimiterToken:
+		s = `delimiter`
+	case WordToken:
+		s = `word`
+	default:
+		s = `<invalid>`
+	}
+
+	return
+}
+
+/*
+String returns the literal text of the receiver, reinstating the enclosing quotation marks in the case of a [ValueToken].
+*/
+func (r Token) String() string {
+	if r.Kind == ValueToken {
+		return `"` + r.Value + `"`
+	}
+
+	return r.Value
+}
+
+/*
+Tokenize splits the raw ACI string (or any fragment thereof, such as a single [TargetRule] or [BindRules] expression) into a sequence of typed [Token] instances. Quotation and nesting are respected: characters within a double-quoted value, including parentheses and escaped quotation marks, do not delimit tokens. For example:
+
+	( targetattr != "cn || sn" )(version 3.0; acl "Example"; allow(read) userdn = "ldap:///anyone";)
+
+... yields the tokens `(`, `targetattr`, `!=`, `"cn || sn"`, `)`, `(`, `version`, `3.0`, `;`, and so on.
+
+The Boolean WORD operators AND, OR and NOT are recognized regardless of case, and the `AND NOT` sequence is returned as a single [BooleanToken].
+
+An error is returned if the input contains an unterminated quoted value, unbalanced parentheses or an unrecognized operator character. No grammatical validation is performed; this function is intended as a foundation for custom processors, and does not supplant the parsers of this package.
+*/
+func Tokenize(raw string) (tokens []Token, err error) {
+	var depth int
+	for i := 0; i < len(raw); {
+		c := raw[i]
+		switch {
+		case isSpace(c):
+			i++
+
+		case c == '(' || c == ')':
+			if c == '(' {
+				depth++
+			} else if depth--; depth < 0 {
+				return nil, tokenizeErr(i, `unbalanced closing parenthesis`)
+			}
+			tokens = append(tokens, Token{ParenToken, string(c), i})
+			i++
+
+		case c == '"':
+			end := valueEnd(raw, i+1)
+			if end == len(raw) {
+				return nil, tokenizeErr(i, `unterminated quoted value`)
+			}
+			tokens = append(tokens, Token{ValueToken, raw[i+1 : end], i})
+			i = end + 1
+
+		case c == ';' || c == ',':
+			tokens = append(tokens, Token{DelimiterToken, string(c), i})
+			i++
+
+		case c == '|':
+			if i+1 == len(raw) || raw[i+1] != '|' {
+				return nil, tokenizeErr(i, `lone pipe character`)
+			}
+			tokens = append(tokens, Token{DelimiterToken, `||`, i})
+			i += 2
+
+		case c == '=' || c == '!' || c == '<' || c == '>':
+			end := i + 1
+			if end < len(raw) && raw[end] == '=' {
+				end++
+			}
+			if matchCOP(raw[i:end]) == badCop {
+				return nil, tokenizeErr(i, `unrecognized operator '`+raw[i:end]+`'`)
+			}
+			tokens = append(tokens, Token{OperatorToken, raw[i:end], i})
+			i = end
+
+		default:
+			end := i
+			for end < len(raw) && !isTokenBoundary(raw[end]) {
+				end++
+			}
+			tokens = appendWordToken(tokens, raw, i, end)
+			i = end
+		}
+	}
+
+	if depth != 0 {
+		err = tokenizeErr(len(raw), `unbalanced opening parenthesis`)
+		tokens = nil
+	}
+
+	return
+}
+
+/*
+appendWordToken classifies the unquoted word found at raw[start:end], appending the resultant [Token] to tokens. A NOT following an AND is merged into the preceding [BooleanToken].
+*/
+func appendWordToken(tokens []Token, raw string, start, end int) []Token {
+	word := raw[start:end]
+	kind := WordToken
+
+	switch lc(word) {
+	case `and`, `or`:
+		kind = BooleanToken
+	case `not`:
+		if n := len(tokens); n > 0 && tokens[n-1].Kind == BooleanToken && eq(tokens[n-1].Value, `and`) {
+			tokens[n-1].Value = raw[tokens[n-1].Pos:end]
+			return tokens
+		}
+		kind = BooleanToken
+	case `version`, `acl`, `allow`, `deny`:
+		kind = KeywordToken
+	default:
+		if matchTKW(word) != TargetKeyword(0x0) || matchBKW(word) != BindKeyword(0x0) {
+			kind = KeywordToken
+		}
+	}
+
+	return append(tokens, Token{kind, word, start})
+}
+
+/*
+valueEnd returns the index of the unescaped quotation mark which terminates the quoted value beginning at index i of raw. The length of raw is returned if the value is unterminated.
+*/
+func valueEnd(raw string, i int) int {
+	for ; i < len(raw); i++ {
+		if raw[i] == '\\' {
+			i++
+		} else if raw[i] == '"' {
+			return i
+		}
+	}
+
+	return len(raw)
+}
+
+/*
+isTokenBoundary returns a Boolean value indicative of whether c terminates an unquoted word.
+*/
+func isTokenBoundary(c byte) bool {
+	return isSpace(c) || contains(`()";,|=!<>`, string(c))
+}
+
+/*
+isSpace returns a Boolean value indicative of whether c is an ASCII whitespace character.
+*/
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
@@ -0,0 +1,83 @@
+package aci
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleTokenize() {
+	tokens, err := Tokenize(`( targetattr != "cn || sn" )(version 3.0; acl "Example"; allow(read) userdn = "ldap:///anyone";)`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, token := range tokens[:8] {
+		fmt.Printf("%s: %s\n", token.Kind, token)
+	}
+	// Output:
+	// paren: (
+	// keyword: targetattr
+	// operator: !=
+	// value: "cn || sn"
+	// paren: )
+	// paren: (
+	// keyword: version
+	// word: 3.0
+}
+
+func TestTokenize(t *testing.T) {
+	raw := `(userdn="ldap:///uid=\"x(y)\",dc=example" AND NOT (ssf>=128 or ip = 192.168.*)) || ;`
+	want := []Token{
+		{ParenToken, `(`, 0},
+		{KeywordToken, `userdn`, 1},
+		{OperatorToken, `=`, 7},
+		{ValueToken, `ldap:///uid=\"x(y)\",dc=example`, 8},
+		{BooleanToken, `AND NOT`, 42},
+		{ParenToken, `(`, 50},
+		{KeywordToken, `ssf`, 51},
+		{OperatorToken, `>=`, 54},
+		{WordToken, `128`, 56},
+		{BooleanToken, `or`, 60},
+		{KeywordToken, `ip`, 63},
+		{OperatorToken, `=`, 66},
+		{WordToken, `192.168.*`, 68},
+		{ParenToken, `)`, 77},
+		{ParenToken, `)`, 78},
+		{DelimiterToken, `||`, 80},
+		{DelimiterToken, `;`, 83},
+	}
+
+	got, err := Tokenize(raw)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if len(got) != len(want) {
+		t.Errorf("%s failed: want %d tokens, got %d: %v", t.Name(), len(want), len(got), got)
+		return
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("%s[%d] failed:\nwant: %#v\ngot:  %#v", t.Name(), i, want[i], got[i])
+			return
+		}
+	}
+
+	for idx, bogus := range []string{
+		`( targetattr = "cn )`,
+		`( targetattr = "cn"`,
+		`targetattr = "cn" )`,
+		`userdn | "x"`,
+		`ssf ! 128`,
+	} {
+		if tokens, err := Tokenize(bogus); err == nil {
+			t.Errorf("%s[bogus %d] failed: expected error, got %v", t.Name(), idx, tokens)
+			return
+		}
+	}
+
+	if TokenKind(0).String() != `<invalid>` {
+		t.Errorf("%s failed: unexpected zero %T string", t.Name(), TokenKind(0))
+	}
+}
@@ -60,6 +60,55 @@ func URI(x ...any) LDAPURI {
 	return LDAPURI{newLDAPURI(x...)}
 }
 
+/*
+URL initializes, validates and returns a new instance of [LDAPURI] based upon the raw LDAP URL input value, which must honor the following syntax:
+
+	ldap://[<host>[:<port>]]/<dn>[?<at[,...]>[?<scope>[?<filter>]]]
+
+As a practical example:
+
+	ldap:///ou=People,dc=example,dc=com??sub?(objectClass=employee)
+
+The components of the raw value are verified as follows:
+
+  - The scheme must be `ldap`
+  - The hostport, if present, must be a valid host (with an optional numerical port); it is discarded following verification, as the local scheme (ldap:///) is ALWAYS imposed during string representation
+  - The DN must be a valid distinguished name
+  - The attributes list, if present, must be a comma-delimited list of valid attributeType names (or a single [AttributeBindTypeOrValue])
+  - The scope, if present, must be one of `base`, `one` or `sub`
+  - The filter, if present, must be a well-formed LDAP Search Filter
+
+An optional [BindKeyword] may be provided. If [BindGDN] is provided, the return value shall produce `groupdn` [BindRule] instances rather than `userdn` [BindRule] instances. If [BindGAT] is provided, any [AttributeBindTypeOrValue] component shall bear the `groupattr` keyword.
+
+A bogus (zero) [LDAPURI] instance is returned if the raw value fails validation. Use the [LDAPURI.Parse] method in cases where the precise error is needed, bearing in mind that said method does not tolerate a hostport.
+
+Note this function is not to be confused with the [LDAPURL] [BindType] constant.
+*/
+func URL(raw string, kw ...BindKeyword) (L LDAPURI) {
+	L, _ = parseURL(raw, kw...)
+	return
+}
+
+/*
+parseURL is a private function called by [URL] and parseATBTV. A bogus (zero) [LDAPURI] is returned alongside an error if the raw value fails validation.
+*/
+func parseURL(raw string, kw ...BindKeyword) (L LDAPURI, err error) {
+	uri, err := chopURIPfx(raw)
+	if err == nil {
+		L, err = parseLDAPURI(LocalScheme+uri, kw...)
+	}
+
+	if err != nil {
+		return LDAPURI{}, err
+	}
+
+	if len(kw) > 0 && kw[0] == BindGDN {
+		L.ldapURI.dn.distinguishedName.Keyword = BindGDN
+	}
+
+	return
+}
+
 /*
 newLDAPURI is a private function called by URI.
 */
@@ -99,12 +148,11 @@ func (r *ldapURI) assertURIComponents(vals []string, kw ...BindKeyword) (err err
 			// keyword does not matter here; any DN func would do
 			// so we'll just use UDN.
 			r.set(UDN(vals[i]))
+			err = r.dn.Valid()
 
 		case 1:
 			// case match is ATBTV -OR- Search Attribute(s)
-			if err = r.uriAssertATB(vals[i], kw...); err != nil {
-				return
-			}
+			err = r.uriAssertATB(vals[i], kw...)
 
 		case 2:
 			// case match is the LDAP Search Scope, if defined. Note that
@@ -112,24 +160,61 @@ func (r *ldapURI) assertURIComponents(vals []string, kw ...BindKeyword) (err err
 			// scope if not specified, it is not required in the value
 			// and, thus, this package shall not impose the default on
 			// its own.
-
-			// targetscope value is not appropriate for LDAP URI
-			// scope, and because there is no obvious alternative,
-			// we won't set anything. Allow anything else.
-			if sc := strToScope(vals[i]); len(vals[i]) > 0 {
-				// Submit new value to LDAPURI instance
-				r.set(sc)
-			}
+			err = r.uriAssertScope(vals[i])
 
 		case 3:
 			// case match is LDAP Search Filter
-			r.set(Filter(vals[i]))
+			err = r.uriAssertFilter(vals[i])
+		}
+
+		if err != nil {
+			break
 		}
 	}
 
 	return
 }
 
+/*
+uriAssertScope shall analyze the input string value (raw) as an LDAP Search Scope and, if valid, shall set it within the receiver. A zero length value is ignored.
+
+Only the scope names defined within RFC 4516 (base, one and sub) are permitted; the targetscope-specific variations are not appropriate for an LDAP URI.
+*/
+func (r *ldapURI) uriAssertScope(raw string) (err error) {
+	if len(raw) == 0 {
+		return
+	}
+
+	switch lc(raw) {
+	case `base`, `one`, `sub`:
+		// Submit new value to LDAPURI instance
+		r.set(strToScope(raw))
+	default:
+		err = uriBadScopeErr(raw)
+	}
+
+	return
+}
+
+/*
+uriAssertFilter shall analyze the input string value (raw) as an LDAP Search Filter and, if valid, shall set it within the receiver. A zero length value is ignored.
+*/
+func (r *ldapURI) uriAssertFilter(raw string) (err error) {
+	if len(raw) == 0 {
+		return
+	}
+
+	if !isValidFilterSyntax(raw) {
+		err = uriBadFilterErr(raw)
+		return
+	}
+
+	// Submit new value to LDAPURI instance
+	r.set(Filter(raw))
+
+	return
+}
+
 /*
 uriAssertATB shall analyze the input string value (raw) and will do one (1) of the following:
 
@@ -178,9 +263,15 @@ func (r *ldapURI) uriAssertATB(raw string, bkw ...BindKeyword) (err error) {
 
 		// Obliterate spaces and split comma-delimited list
 		// into discrete attributeType names. Finally, we'll
-		// begin iteration ...
+		// begin iteration, rejecting any empty or malformed
+		// names rather than silently discarding them ...
 		for _, attr := range split(repAll(raw, ` `, ``), `,`) {
-			A.Push(AT(attr))
+			at := AT(attr)
+			if at.IsZero() {
+				err = uriBadAttributeErr(attr)
+				return
+			}
+			A.Push(at)
 		}
 
 		// Submit new value(s) to LDAPURI instance
@@ -240,6 +331,15 @@ func (r LDAPURI) BRM() BindRuleMethods {
 	})
 }
 
+/*
+Rule returns a [BindRule] bearing the receiver as its expression value and the [ComparisonOperator] identified by op, which may be a [ComparisonOperator] constant, symbol (e.g.: `!=`) or name (e.g.: `Ne`). A bogus [BindRule] is returned if op is not permitted for use with the receiver.
+
+See also the [LDAPURI.BRM] method.
+*/
+func (r LDAPURI) Rule(op any) BindRule {
+	return r.BRM().rule(op)
+}
+
 /*
 makeBindRule is a private method extended by LDAPURI solely to be executed by the Eq and Ne methods during BindRule assembly.
 */
@@ -295,3 +295,91 @@ func ExampleLDAPURI_Compare() {
 	fmt.Printf("Hashes are equal: %t", uri1.Compare(uri2))
 	// Output: Hashes are equal: false
 }
+
+func ExampleURL() {
+	uri := URL(`ldap://ldap.example.com:389/ou=People,dc=example,dc=com??sub?(objectClass=employee)`)
+	fmt.Printf("%s", uri.Eq())
+	// Output: userdn = "ldap:///ou=People,dc=example,dc=com??sub?(objectClass=employee)"
+}
+
+func ExampleURL_groupDN() {
+	uri := URL(`ldap:///ou=Groups,dc=example,dc=com??one?(objectClass=groupOfNames)`, BindGDN)
+	fmt.Printf("%s", uri.Eq())
+	// Output: groupdn = "ldap:///ou=Groups,dc=example,dc=com??one?(objectClass=groupOfNames)"
+}
+
+func TestURL(t *testing.T) {
+	for _, bogus := range []string{
+		``,
+		`http:///ou=People,dc=example,dc=com??sub?`,
+		`ldap://ldap.example.com:99999/ou=People,dc=example,dc=com??sub?`,
+		`ldap://-bad-/ou=People,dc=example,dc=com??sub?`,
+		`ldap://ldap.example.com`,
+		`ldap:///ou=People,dc=example,dc=com??subtree?`,
+		`ldap:///ou=People,dc=example,dc=com??sub?(objectClass=*`,
+		`ldap:///ou=People,dc=example,dc=com??sub?objectClass=*`,
+		`ldap:///ou=People,dc=example,dc=com??sub?(&(objectClass=*)(=x))`,
+		`ldap:///ou=People,dc=example,dc=com??sub?(objectClass=*)?x?y`,
+		`ldap:///bogus??sub?(objectClass=*)`,
+		`ldap:///ou=x??badscope?(cn=*)`,
+		`ldap:///ou=People,dc=example,dc=com?cn,,sn?sub?(objectClass=*)`,
+		`ldap:///ou=People,dc=example,dc=com?1cn?sub?(objectClass=*)`,
+	} {
+		if uri := URL(bogus); !uri.IsZero() {
+			t.Errorf("%s failed: bogus URL '%s' returned no error",
+				t.Name(), bogus)
+			return
+		}
+	}
+
+	for _, valid := range []string{
+		`ldap:///ou=People,dc=example,dc=com??base?(objectClass=*)`,
+		`ldap://[2001:db8::1]:636/ou=People,dc=example,dc=com?cn,sn?one?(|(cn=a)(!(sn=b)))`,
+		`ldap://192.0.2.1/ou=People,dc=example,dc=com??sub?(&)`,
+		`ldap:///ou=People,dc=example,dc=com?manager#USERDN`,
+	} {
+		if err := URL(valid).Valid(); err != nil {
+			t.Errorf("%s failed: valid URL '%s' returned error: %v",
+				t.Name(), valid, err)
+			return
+		}
+	}
+}
+
+func TestURI_bindRuleComponents(t *testing.T) {
+	for _, bogus := range []string{
+		`userdn = "ldap:///ou=x??badscope?(cn=*)"`,
+		`groupdn = "ldap:///ou=x??sub?cn=*)"`,
+		`roledn = "ldap:///ou=x?c!n?sub?(cn=*)"`,
+		`userdn = "ldap:///ou=x??sub?(cn=*)" || "ldap:///ou=y??one?(cn=*"`,
+	} {
+		if _, err := ParseBindRules(bogus); err == nil {
+			t.Errorf("%s failed: bogus bind rules '%s' returned no error",
+				t.Name(), bogus)
+			return
+		}
+
+		if br, err := ParseBindRule(bogus); err == nil && br.Valid() == nil {
+			t.Errorf("%s failed: bogus bind rule '%s' returned no error",
+				t.Name(), bogus)
+			return
+		}
+	}
+
+	for _, valid := range []string{
+		`userdn = "ldap:///ou=x?cn,sn?one?(cn=*)"`,
+		`groupdn = "ldap:///ou=x??sub?(objectClass=groupOfURLs)"`,
+		`userattr = "ldap:///ou=x?manager#USERDN"`,
+		`userdn = "ldap:///anyone"`,
+	} {
+		br, err := ParseBindRule(valid)
+		if err == nil {
+			err = br.Valid()
+		}
+		if err != nil {
+			t.Errorf("%s failed: valid bind rule '%s' returned error: %v",
+				t.Name(), valid, err)
+			return
+		}
+	}
+}